@@ -0,0 +1,8 @@
+data "gocd_agent_job_history" "sample_agent" {
+  uuid  = "4c92e7e3-8abd-4b02-a7eb-c46b2c7ac674"
+  limit = 20
+}
+
+output "failed_jobs_on_agent" {
+  value = [for job in data.gocd_agent_job_history.sample_agent.jobs : "${job.pipeline}/${job.pipeline_counter}/${job.stage}/${job.stage_counter}/${job.job}" if job.result == "Failed"]
+}
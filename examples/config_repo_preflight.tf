@@ -0,0 +1,14 @@
+data "gocd_config_repo_preflight" "sample_pipelines" {
+  plugin_id = "yaml.config.plugin"
+  repo_id   = gocd_config_repository.sample_config_repo_yamll.id
+  files = {
+    "pipeline.gocd.yaml" = file("${path.module}/pipelines/pipeline.gocd.yaml")
+  }
+
+  lifecycle {
+    postcondition {
+      condition     = self.valid
+      error_message = join("\n", self.errors)
+    }
+  }
+}
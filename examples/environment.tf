@@ -21,4 +21,19 @@ resource "gocd_environment" "sample_environment" {
 data "gocd_environment" "sample_environment" {
   depends_on = [gocd_environment.sample_environment]
   name       = gocd_environment.sample_environment.id
-}
\ No newline at end of file
+}
+resource "gocd_environment_pipeline" "helm_images" {
+  environment = "sample_environment"
+  pipeline    = "helm-images"
+}
+
+resource "gocd_environment_agent" "sample_agent" {
+  environment = "sample_environment"
+  agent_id    = "0dc8b84e-2b9c-4e3c-8ba8-6a2e4f5c1e2b"
+}
+
+resource "gocd_environment_variable" "team_env" {
+  environment = "sample_environment"
+  name        = "TEAM"
+  value       = "platform"
+}
@@ -0,0 +1,21 @@
+# latest instance of the pipeline in which the stage `build` passed.
+data "gocd_job_artifact" "image_digest" {
+  pipeline = "build-image"
+  stage    = "build"
+  job      = "docker"
+  path     = "out/image-digest.txt"
+}
+
+data "gocd_job_artifact" "release_bundle" {
+  pipeline         = "release"
+  pipeline_counter = 42
+  stage            = "package"
+  stage_counter    = "1"
+  job              = "bundle"
+  path             = "dist/bundle.tar.gz"
+  max_size         = 10485760
+}
+
+output "image_digest" {
+  value = trimspace(data.gocd_job_artifact.image_digest.content)
+}
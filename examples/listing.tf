@@ -0,0 +1,69 @@
+data "gocd_pipelines" "deploy" {
+  name_regex = "^deploy-"
+
+  filter {
+    name   = "group"
+    values = ["production"]
+  }
+}
+
+data "gocd_pipeline_groups" "teams" {
+  name_regex = "^team-"
+}
+
+data "gocd_environments" "with_deploy" {
+  filter {
+    name   = "pipelines"
+    values = ["deploy"]
+  }
+}
+
+data "gocd_roles" "ldap_roles" {
+  filter {
+    name   = "auth_config_id"
+    values = ["ldap"]
+  }
+}
+
+data "gocd_cluster_profiles" "kubernetes" {
+  plugin_id = "cd.go.contrib.elasticagent.kubernetes"
+}
+
+data "gocd_elastic_agent_profiles" "kubernetes" {
+  filter {
+    name   = "cluster_profile_id"
+    values = ["kubernetes"]
+  }
+}
+
+data "gocd_secret_configs" "file_based" {
+  plugin_id = "cd.go.secrets.file-based-plugin"
+}
+
+data "gocd_config_repositories" "yaml" {
+  plugin_id = "yaml.config.plugin"
+
+  filter {
+    name   = "branch"
+    values = ["main", "master"]
+  }
+}
+
+data "gocd_artifact_stores" "docker" {
+  plugin_id = "cd.go.artifact.docker.registry"
+}
+
+data "gocd_auth_configs" "ldap" {
+  plugin_id = "cd.go.authentication.ldap"
+}
+
+output "deploy_pipelines" {
+  value = data.gocd_pipelines.deploy.names
+}
+
+data "gocd_plugins" "kubernetes_elastic_agent" {
+  extension        = "elastic-agent"
+  status           = "active"
+  name_regex       = "kubernetes"
+  required_plugins = ["cd.go.contrib.elasticagent.kubernetes"]
+}
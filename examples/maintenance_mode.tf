@@ -0,0 +1,10 @@
+resource "gocd_maintenance_mode" "upgrade" {
+  enabled       = true
+  wait_for_jobs = true
+  poll_interval = "30s"
+
+  timeouts {
+    create = "1h"
+    update = "1h"
+  }
+}
@@ -0,0 +1,18 @@
+data "gocd_materials" "git" {
+  filter {
+    name   = "type"
+    values = ["git"]
+  }
+}
+
+output "materials_with_errors" {
+  value = [for material in data.gocd_materials.git.materials : material.url if length(material.errors) != 0]
+}
+
+resource "gocd_material_update_trigger" "sample_repo" {
+  fingerprint = "0a2b4c6d8e0f1a3b5c7d9e1f3a5b7c9d1e3f5a7b9c1d3e5f7a9b1c3d5e7f9a1b"
+  triggers = {
+    # ex: the commit pushed to the repository by terraform.
+    revision = "1"
+  }
+}
@@ -0,0 +1,6 @@
+resource "gocd_notification_filter" "sample_pipeline_breaks" {
+  pipeline      = "sample-pipeline"
+  stage         = "[Any Stage]"
+  event         = "Breaks"
+  match_commits = true
+}
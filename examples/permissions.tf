@@ -0,0 +1,7 @@
+data "gocd_permissions" "current" {
+  types = ["pipeline_group", "environment"]
+}
+
+output "administrable_pipeline_groups" {
+  value = data.gocd_permissions.current.pipeline_group[0].administer
+}
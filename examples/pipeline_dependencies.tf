@@ -0,0 +1,19 @@
+data "gocd_value_stream_map" "deploy" {
+  pipeline = "deploy"
+}
+
+data "gocd_pipeline_dependencies" "build" {
+  pipeline = "build"
+}
+
+output "deploy_upstream_pipelines" {
+  value = [for node in data.gocd_value_stream_map.deploy.nodes : node.name if node.type == "PIPELINE"]
+}
+
+output "orphaned_on_deleting_build" {
+  value = data.gocd_pipeline_dependencies.build.all_downstream
+}
+
+output "dependency_cycles" {
+  value = data.gocd_pipeline_dependencies.build.cycles
+}
@@ -36,6 +36,18 @@ resource "gocd_pipeline_group" "sample_group_3" {
   }
 }
 
+resource "gocd_pipeline_group_permission" "sample_group_operators" {
+  pipeline_group = "sample-group"
+  permission     = "operate"
+  role           = "sample"
+}
+
+resource "gocd_pipeline_group_permission" "sample_group_viewer" {
+  pipeline_group = "sample-group"
+  permission     = "view"
+  user           = "jane"
+}
+
 data "gocd_pipeline_group" "sample_group" {
   group_id = "sample-group"
 }
\ No newline at end of file
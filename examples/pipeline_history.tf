@@ -0,0 +1,21 @@
+data "gocd_pipeline_history" "deploy" {
+  pipeline = "deploy"
+  limit    = 5
+}
+
+data "gocd_pipeline_instance" "latest_deploy" {
+  pipeline = "deploy"
+}
+
+data "gocd_pipeline_instance" "deploy" {
+  pipeline = "deploy"
+  counter  = data.gocd_pipeline_history.deploy.latest_counter
+}
+
+output "deploy_passed" {
+  value = data.gocd_pipeline_history.deploy.latest_result == "Passed"
+}
+
+output "deploy_stages" {
+  value = { for stage in data.gocd_pipeline_instance.deploy.stages : stage.name => stage.result }
+}
@@ -0,0 +1,7 @@
+data "gocd_pipeline_status" "deploy" {
+  pipeline = "deploy"
+}
+
+output "deploy_runnable" {
+  value = !data.gocd_pipeline_status.deploy.paused && data.gocd_pipeline_status.deploy.schedulable
+}
@@ -14,6 +14,8 @@ provider "gocd" {
   //  auth_token = "d8fccbc997d04e917b1490af8e7bf46290ab8c99"
   loglevel = "debug"
   //  skip_check = true
+  //  request_timeout = "30s"
+  //  proxy_url       = "http://proxy.sample.com:3128"
   retries {
     count     = 10
     wait_time = 2
@@ -56,6 +56,29 @@ resource "gocd_role" "sample_ldap" {
   }
 }
 
+resource "gocd_role" "sample_viewer" {
+  name = "sample-viewer"
+  type = "gocd"
+  policy {
+    permission = "allow"
+    action     = "view"
+    type       = "environment"
+    resource   = "team-*"
+  }
+}
+
+resource "gocd_role_membership" "sample_member" {
+  role = gocd_role.sample.id
+  user = "jane"
+}
+
+resource "gocd_role_binding" "sample_github_team" {
+  role      = "sample-github"
+  group     = "platform"
+  property  = "Teams"
+  separator = ","
+}
+
 
 data "gocd_role" "sample" {
   name = gocd_role.sample.id
@@ -0,0 +1,5 @@
+data "gocd_server_info" "server" {}
+
+output "gocd_server_version" {
+  value = data.gocd_server_info.server.version
+}
@@ -0,0 +1,14 @@
+resource "gocd_server_ready" "server" {
+  triggers = {
+    # ex: revision of the helm release that deploys GoCD.
+    revision = "1"
+  }
+
+  timeouts {
+    create = "15m"
+  }
+}
+
+data "gocd_server_ready" "server" {
+  max_interval = "1m"
+}
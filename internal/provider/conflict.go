@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+// patchAttempts is the number of times an object is patched, when it keeps getting modified outside of terraform while patching it.
+const patchAttempts = 3
+
+// updateOnConflict updates the object with the etag known to terraform, when GoCD rejects it since the object was modified in between
+// (412 Precondition Failed), the latest object is fetched and the conflict is handled based on the `on_conflict` set on the provider.
+//
+// base is the object as known to terraform before the update and desired is the one terraform is applying, the changes made on the server
+// are the fields that differ between base and the latest object compared in the shape of base. With `retry`, desired changes are applied on the latest object and updated
+// again with its etag as long as they do not overlap with the changes made on the server, else it fails with the diff of the changes.
+func updateOnConflict[T any](ctx context.Context, meta interface{}, kind, name string, base, desired T, etag string,
+	fetch func() (T, string, error), update func(object T, etag string) error,
+) error {
+	err := update(desired, etag)
+	if err == nil || !isPreconditionFailed(err) {
+		return err
+	}
+
+	latest, latestETag, fetchErr := fetch()
+	if fetchErr != nil {
+		return fmt.Errorf("%s '%s' was modified outside of terraform, fetching its latest config errored with: %w", kind, name, fetchErr)
+	}
+
+	merged, overlapping, diff, mergeErr := mergeChanges(base, desired, latest)
+	if mergeErr != nil {
+		return fmt.Errorf("%s '%s' was modified outside of terraform, comparing the changes errored with: %w", kind, name, mergeErr)
+	}
+
+	if getOnConflict(meta) == client.OnConflictFail || len(overlapping) != 0 {
+		return fmt.Errorf("%s '%s' was modified outside of terraform (on_conflict: %s, overlapping fields: [%s]), changes made outside of terraform:\n%s",
+			kind, name, getOnConflict(meta), strings.Join(overlapping, ", "), diff)
+	}
+
+	tflog.Info(ctx, "object was modified outside of terraform without overlapping the changes being applied, updating again with the latest etag",
+		map[string]interface{}{"kind": kind, "name": name})
+
+	return update(merged, latestETag)
+}
+
+// patchOnConflict applies the changes made by patch on the latest object fetched from GoCD and updates it with its etag, the update
+// is skipped when patch reports no changes. When GoCD rejects the update since the object was modified in between (412 Precondition Failed),
+// the whole operation is retried on the freshly fetched object, unless `on_conflict` set on the provider is `fail`.
+func patchOnConflict[T any](ctx context.Context, meta interface{}, kind, name string,
+	fetch func() (T, error), patch func(object *T) (bool, error), update func(object T) error,
+) error {
+	for attempt := 1; ; attempt++ {
+		object, err := fetch()
+		if err != nil {
+			return fmt.Errorf("fetching %s '%s' errored with: %w", kind, name, err)
+		}
+
+		changed, err := patch(&object)
+		if err != nil {
+			return err
+		}
+
+		if !changed {
+			tflog.Debug(ctx, "object is already up to date so skipping", map[string]interface{}{"kind": kind, "name": name})
+
+			return nil
+		}
+
+		err = update(object)
+		if err == nil {
+			return nil
+		}
+
+		if !isPreconditionFailed(err) || getOnConflict(meta) == client.OnConflictFail || attempt == patchAttempts {
+			return fmt.Errorf("updating %s '%s' errored with (on_conflict: %s): %w", kind, name, getOnConflict(meta), err)
+		}
+
+		tflog.Info(ctx, "object was modified while updating it, retrying on its latest config",
+			map[string]interface{}{"kind": kind, "name": name, "attempt": attempt})
+	}
+}
+
+// mergeChanges applies the fields changed by terraform (base to desired) on the latest object, it returns the fields that were changed
+// both by terraform and outside of terraform differently, along with the diff of the changes made outside of terraform.
+func mergeChanges[T any](base, desired, latest T) (T, []string, string, error) {
+	var merged T
+
+	baseFields, err := toFields(base)
+	if err != nil {
+		return merged, nil, "", err
+	}
+
+	desiredFields, err := toFields(desired)
+	if err != nil {
+		return merged, nil, "", err
+	}
+
+	latestFields, err := toFields(latest)
+	if err != nil {
+		return merged, nil, "", err
+	}
+
+	keys := make(map[string]bool)
+	for _, fields := range []map[string]interface{}{baseFields, desiredFields, latestFields} {
+		for key := range fields {
+			// etag and the hypermedia links are not part of the config.
+			if !strings.EqualFold(key, "etag") && !strings.HasPrefix(key, "_") {
+				keys[key] = true
+			}
+		}
+	}
+
+	mergedFields := make(map[string]interface{})
+	overlapping := make([]string, 0)
+
+	var diff strings.Builder
+
+	for _, key := range sortedKeys(keys) {
+		// GoCD returns the objects with its defaults filled in and the secure values encrypted, latest is compared
+		// in the shape of base and desired, so that only the changes made on the server are considered.
+		latestAsBase := normalizeLatest(baseFields[key], latestFields[key])
+
+		changedByTerraform := !reflect.DeepEqual(baseFields[key], desiredFields[key])
+		changedOnServer := !reflect.DeepEqual(baseFields[key], latestAsBase)
+
+		if changedOnServer {
+			diff.WriteString(fmt.Sprintf("%s:\n%s\n", key, cmp.Diff(baseFields[key], latestAsBase)))
+		}
+
+		value, found := desiredFields[key]
+		if changedOnServer && !changedByTerraform {
+			value, found = latestFields[key]
+		}
+
+		if changedOnServer && changedByTerraform && !reflect.DeepEqual(normalizeLatest(desiredFields[key], latestFields[key]), desiredFields[key]) {
+			overlapping = append(overlapping, key)
+		}
+
+		if found {
+			mergedFields[key] = value
+		}
+	}
+
+	mergedJSON, err := json.Marshal(mergedFields)
+	if err != nil {
+		return merged, nil, "", err
+	}
+
+	if err = json.Unmarshal(mergedJSON, &merged); err != nil {
+		return merged, nil, "", err
+	}
+
+	return merged, overlapping, diff.String(), nil
+}
+
+// normalizeLatest returns the latest value of a field in the shape of the known value, by dropping the keys of the objects
+// not known to terraform, ex: the defaults filled in by GoCD. Secure values are returned encrypted by GoCD, so the plain text
+// value known to terraform is retained when the latest object carries an encrypted value in its place.
+func normalizeLatest(known, latest interface{}) interface{} {
+	switch knownValue := known.(type) {
+	case map[string]interface{}:
+		latestValue, ok := latest.(map[string]interface{})
+		if !ok {
+			return latest
+		}
+
+		_, knownEncrypted := knownValue["encrypted_value"]
+		_, latestEncrypted := latestValue["encrypted_value"]
+
+		normalized := make(map[string]interface{}, len(knownValue))
+		for key, value := range knownValue {
+			if latestEncrypted && !knownEncrypted && (key == "value" || key == "secure" || key == "is_secure") {
+				normalized[key] = value
+
+				continue
+			}
+
+			if field, found := latestValue[key]; found {
+				normalized[key] = normalizeLatest(value, field)
+			}
+		}
+
+		return normalized
+	case []interface{}:
+		latestValue, ok := latest.([]interface{})
+		if !ok || len(latestValue) != len(knownValue) {
+			return latest
+		}
+
+		normalized := make([]interface{}, len(latestValue))
+		for index := range latestValue {
+			normalized[index] = normalizeLatest(knownValue[index], latestValue[index])
+		}
+
+		return normalized
+	default:
+		return latest
+	}
+}
+
+func toFields(object interface{}) (map[string]interface{}, error) {
+	objectJSON, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{})
+	if err = json.Unmarshal(objectJSON, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
+
+func sortedKeys(keys map[string]bool) []string {
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+
+	sort.Strings(sorted)
+
+	return sorted
+}
+
+// getOldValue returns a getter of the values known to terraform before the changes being applied.
+func getOldValue(d *schema.ResourceData) func(key string) interface{} {
+	return func(key string) interface{} {
+		oldValue, _ := d.GetChange(key)
+
+		return oldValue
+	}
+}
+
+func isPreconditionFailed(err error) bool {
+	return client.StatusCode(err) == http.StatusPreconditionFailed
+}
+
+func getOnConflict(meta interface{}) string {
+	if goCDClient, ok := meta.(client.GoCD); ok && len(goCDClient.OnConflict) != 0 {
+		return goCDClient.OnConflict
+	}
+
+	return client.OnConflictRetry
+}
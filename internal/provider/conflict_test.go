@@ -0,0 +1,401 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+type conflictObject struct {
+	Name      string            `json:"name,omitempty"`
+	Pipelines []string          `json:"pipelines,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	ETAG      string            `json:"etag,omitempty"`
+}
+
+func TestMergeChanges(t *testing.T) {
+	tests := []struct {
+		name                string
+		base                conflictObject
+		desired             conflictObject
+		latest              conflictObject
+		expected            conflictObject
+		expectedOverlapping []string
+		expectedDiffKeys    []string
+	}{
+		{
+			name:                "nothing changed on the server",
+			base:                conflictObject{Name: "sample", Pipelines: []string{"build"}},
+			desired:             conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			latest:              conflictObject{Name: "sample", Pipelines: []string{"build"}},
+			expected:            conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			expectedOverlapping: []string{},
+		},
+		{
+			name:                "changes on the server to the other fields are retained",
+			base:                conflictObject{Name: "sample", Pipelines: []string{"build"}},
+			desired:             conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			latest:              conflictObject{Name: "sample", Pipelines: []string{"build"}, Labels: map[string]string{"team": "infra"}},
+			expected:            conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}, Labels: map[string]string{"team": "infra"}},
+			expectedOverlapping: []string{},
+			expectedDiffKeys:    []string{"labels"},
+		},
+		{
+			name:                "same field changed differently on the server overlaps",
+			base:                conflictObject{Name: "sample", Pipelines: []string{"build"}},
+			desired:             conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			latest:              conflictObject{Name: "sample", Pipelines: []string{"build", "test"}},
+			expected:            conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			expectedOverlapping: []string{"pipelines"},
+			expectedDiffKeys:    []string{"pipelines"},
+		},
+		{
+			name:                "same field changed identically on the server does not overlap",
+			base:                conflictObject{Name: "sample", Pipelines: []string{"build"}},
+			desired:             conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			latest:              conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			expected:            conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}},
+			expectedOverlapping: []string{},
+			expectedDiffKeys:    []string{"pipelines"},
+		},
+		{
+			name:                "field removed on the server is removed when terraform does not change it",
+			base:                conflictObject{Name: "sample", Labels: map[string]string{"team": "infra"}},
+			desired:             conflictObject{Name: "sample", Labels: map[string]string{"team": "infra"}, Pipelines: []string{"build"}},
+			latest:              conflictObject{Name: "sample"},
+			expected:            conflictObject{Name: "sample", Pipelines: []string{"build"}},
+			expectedOverlapping: []string{},
+			expectedDiffKeys:    []string{"labels"},
+		},
+		{
+			name:                "etag is not considered as a change",
+			base:                conflictObject{Name: "sample", ETAG: "one"},
+			desired:             conflictObject{Name: "sample", ETAG: "one"},
+			latest:              conflictObject{Name: "sample", ETAG: "two"},
+			expected:            conflictObject{Name: "sample"},
+			expectedOverlapping: []string{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, overlapping, diff, err := mergeChanges(test.base, test.desired, test.latest)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if d := cmp.Diff(test.expected, merged); d != "" {
+				t.Errorf("merged object mismatch (-expected +actual):\n%s", d)
+			}
+
+			if d := cmp.Diff(test.expectedOverlapping, overlapping); d != "" {
+				t.Errorf("overlapping fields mismatch (-expected +actual):\n%s", d)
+			}
+
+			for _, key := range test.expectedDiffKeys {
+				if !strings.Contains(diff, key+":") {
+					t.Errorf("expected diff to contain the changes of '%s', got:\n%s", key, diff)
+				}
+			}
+
+			if len(test.expectedDiffKeys) == 0 && len(diff) != 0 {
+				t.Errorf("expected no diff, got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMergeChangesNormalizedByGoCD(t *testing.T) {
+	t.Run("pipeline config with the defaults filled in by GoCD", func(t *testing.T) {
+		base := map[string]interface{}{
+			"name":  "sample",
+			"group": "default",
+			"environment_variables": []interface{}{
+				map[string]interface{}{"name": "TOKEN", "secure": true, "value": "secret"},
+			},
+			"stages": []interface{}{
+				map[string]interface{}{"name": "build", "jobs": []interface{}{map[string]interface{}{"name": "compile"}}},
+			},
+		}
+		desired := map[string]interface{}{
+			"name":  "sample",
+			"group": "default",
+			"environment_variables": []interface{}{
+				map[string]interface{}{"name": "TOKEN", "secure": true, "value": "secret"},
+			},
+			"stages": []interface{}{
+				map[string]interface{}{"name": "build", "jobs": []interface{}{map[string]interface{}{"name": "compile"}}},
+				map[string]interface{}{"name": "deploy", "jobs": []interface{}{map[string]interface{}{"name": "release"}}},
+			},
+		}
+		latest := map[string]interface{}{
+			"_links":         map[string]interface{}{"self": map[string]interface{}{"href": "https://gocd/go/api/admin/pipelines/sample"}},
+			"name":           "sample",
+			"group":          "default",
+			"label_template": "${COUNT}",
+			"lock_behavior":  "none",
+			"origin":         map[string]interface{}{"type": "gocd"},
+			"environment_variables": []interface{}{
+				map[string]interface{}{"name": "TOKEN", "secure": true, "encrypted_value": "AES:encrypted"},
+			},
+			"stages": []interface{}{
+				map[string]interface{}{
+					"name": "build", "fetch_materials": true, "clean_working_directory": false, "never_cleanup_artifacts": false,
+					"approval": map[string]interface{}{"type": "success", "allow_only_on_success": false},
+					"jobs":     []interface{}{map[string]interface{}{"name": "compile", "run_instance_count": nil, "timeout": nil}},
+				},
+			},
+		}
+
+		merged, overlapping, _, err := mergeChanges(base, desired, latest)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(overlapping) != 0 {
+			t.Errorf("expected the defaults filled in by GoCD not to overlap, got: %v", overlapping)
+		}
+
+		if d := cmp.Diff(desired["stages"], merged["stages"]); d != "" {
+			t.Errorf("merged stages mismatch (-expected +actual):\n%s", d)
+		}
+
+		if merged["label_template"] != "${COUNT}" {
+			t.Errorf("expected the fields set only on the server to be retained, got: %v", merged)
+		}
+	})
+
+	t.Run("secure properties of a cluster profile", func(t *testing.T) {
+		base := gocd.CommonConfig{ID: "sample", PluginID: "cd.go.contrib.elastic-agent.docker", Properties: []gocd.PluginConfiguration{
+			{Key: "go_server_url", Value: "https://gocd/go"}, {Key: "password", Value: "secret", IsSecure: true},
+		}}
+		desired := gocd.CommonConfig{ID: "sample", PluginID: "cd.go.contrib.elastic-agent.docker", Properties: []gocd.PluginConfiguration{
+			{Key: "go_server_url", Value: "https://gocd.example.com/go"}, {Key: "password", Value: "secret", IsSecure: true},
+		}}
+
+		tests := []struct {
+			name                string
+			latest              []gocd.PluginConfiguration
+			expectedOverlapping []string
+		}{
+			{
+				name: "encrypted value returned by GoCD does not overlap",
+				latest: []gocd.PluginConfiguration{
+					{Key: "go_server_url", Value: "https://gocd/go"}, {Key: "password", EncryptedValue: "AES:encrypted"},
+				},
+				expectedOverlapping: []string{},
+			},
+			{
+				name: "property changed on the server overlaps",
+				latest: []gocd.PluginConfiguration{
+					{Key: "go_server_url", Value: "https://gocd.internal/go"}, {Key: "password", EncryptedValue: "AES:encrypted"},
+				},
+				expectedOverlapping: []string{"properties"},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				latest := gocd.CommonConfig{ID: "sample", PluginID: "cd.go.contrib.elastic-agent.docker", Properties: test.latest}
+
+				_, overlapping, _, err := mergeChanges(base, desired, latest)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if d := cmp.Diff(test.expectedOverlapping, overlapping); d != "" {
+					t.Errorf("overlapping fields mismatch (-expected +actual):\n%s", d)
+				}
+			})
+		}
+	})
+}
+
+func TestUpdateOnConflict(t *testing.T) {
+	preconditionFailed := &goErr.NonOkError{Code: http.StatusPreconditionFailed}
+
+	base := conflictObject{Name: "sample", Pipelines: []string{"build"}}
+	desired := conflictObject{Name: "sample", Pipelines: []string{"build", "deploy"}}
+
+	tests := []struct {
+		name            string
+		onConflict      string
+		latest          conflictObject
+		updateErrors    []error
+		expectErr       string
+		expectedUpdates []conflictObject
+		expectedETags   []string
+	}{
+		{
+			name:            "updated with the etag known to terraform",
+			onConflict:      client.OnConflictRetry,
+			updateErrors:    []error{nil},
+			expectedUpdates: []conflictObject{desired},
+			expectedETags:   []string{"state"},
+		},
+		{
+			name:         "retried with the latest etag when the changes do not overlap",
+			onConflict:   client.OnConflictRetry,
+			latest:       conflictObject{Name: "sample", Pipelines: []string{"build"}, Labels: map[string]string{"team": "infra"}},
+			updateErrors: []error{preconditionFailed, nil},
+			expectedUpdates: []conflictObject{
+				desired,
+				{Name: "sample", Pipelines: []string{"build", "deploy"}, Labels: map[string]string{"team": "infra"}},
+			},
+			expectedETags: []string{"state", "latest"},
+		},
+		{
+			name:            "fails when the changes overlap",
+			onConflict:      client.OnConflictRetry,
+			latest:          conflictObject{Name: "sample", Pipelines: []string{"test"}},
+			updateErrors:    []error{preconditionFailed},
+			expectErr:       "overlapping fields: [pipelines]",
+			expectedUpdates: []conflictObject{desired},
+			expectedETags:   []string{"state"},
+		},
+		{
+			name:            "fails with on_conflict set to fail",
+			onConflict:      client.OnConflictFail,
+			latest:          conflictObject{Name: "sample", Pipelines: []string{"build"}, Labels: map[string]string{"team": "infra"}},
+			updateErrors:    []error{preconditionFailed},
+			expectErr:       "on_conflict: fail",
+			expectedUpdates: []conflictObject{desired},
+			expectedETags:   []string{"state"},
+		},
+		{
+			name:            "errors other than precondition failed are returned as is",
+			onConflict:      client.OnConflictRetry,
+			updateErrors:    []error{client.StatusError{Code: http.StatusUnprocessableEntity, Status: "422 Unprocessable Entity"}},
+			expectErr:       "422",
+			expectedUpdates: []conflictObject{desired},
+			expectedETags:   []string{"state"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			updates := make([]conflictObject, 0)
+			etags := make([]string, 0)
+
+			err := updateOnConflict(context.Background(), client.GoCD{OnConflict: test.onConflict}, "object", "sample", base, desired, "state",
+				func() (conflictObject, string, error) {
+					return test.latest, "latest", nil
+				},
+				func(object conflictObject, etag string) error {
+					updates = append(updates, object)
+					etags = append(etags, etag)
+
+					return test.updateErrors[len(updates)-1]
+				})
+
+			switch {
+			case len(test.expectErr) == 0 && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case len(test.expectErr) != 0 && (err == nil || !strings.Contains(err.Error(), test.expectErr)):
+				t.Fatalf("expected error containing '%s', got: %v", test.expectErr, err)
+			}
+
+			if d := cmp.Diff(test.expectedUpdates, updates); d != "" {
+				t.Errorf("updates mismatch (-expected +actual):\n%s", d)
+			}
+
+			if d := cmp.Diff(test.expectedETags, etags); d != "" {
+				t.Errorf("etags mismatch (-expected +actual):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestIsPreconditionFailed(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "pointer to NonOkError returned by gocd-sdk-go", err: &goErr.NonOkError{Code: http.StatusPreconditionFailed}, expected: true},
+		{name: "NonOkError", err: goErr.NonOkError{Code: http.StatusPreconditionFailed}, expected: true},
+		{name: "wrapped StatusError", err: errors.Join(errors.New("updating"), client.StatusError{Code: http.StatusPreconditionFailed}), expected: true},
+		{name: "other status", err: &goErr.NonOkError{Code: http.StatusNotFound}, expected: false},
+		{name: "error without a response", err: errors.New("connection refused"), expected: false},
+		{name: "nil error", err: nil, expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := isPreconditionFailed(test.err); actual != test.expected {
+				t.Errorf("isPreconditionFailed() = %t, expected %t", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestPatchOnConflict(t *testing.T) {
+	preconditionFailed := &goErr.NonOkError{Code: http.StatusPreconditionFailed}
+
+	tests := []struct {
+		name            string
+		onConflict      string
+		changed         bool
+		updateErrors    []error
+		expectErr       bool
+		expectedFetches int
+		expectedUpdates int
+	}{
+		{name: "updated on the latest object", onConflict: client.OnConflictRetry, changed: true, updateErrors: []error{nil}, expectedFetches: 1, expectedUpdates: 1},
+		{name: "skipped when nothing has changed", onConflict: client.OnConflictRetry, expectedFetches: 1},
+		{
+			name: "retried on the freshly fetched object when modified in between", onConflict: client.OnConflictRetry, changed: true,
+			updateErrors: []error{preconditionFailed, nil}, expectedFetches: 2, expectedUpdates: 2,
+		},
+		{
+			name: "fails after the max attempts", onConflict: client.OnConflictRetry, changed: true,
+			updateErrors: []error{preconditionFailed, preconditionFailed, preconditionFailed}, expectErr: true, expectedFetches: patchAttempts, expectedUpdates: patchAttempts,
+		},
+		{
+			name: "fails without retrying with on_conflict set to fail", onConflict: client.OnConflictFail, changed: true,
+			updateErrors: []error{preconditionFailed}, expectErr: true, expectedFetches: 1, expectedUpdates: 1,
+		},
+		{
+			name: "errors other than precondition failed are not retried", onConflict: client.OnConflictRetry, changed: true,
+			updateErrors: []error{&goErr.NonOkError{Code: http.StatusUnprocessableEntity}}, expectErr: true, expectedFetches: 1, expectedUpdates: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fetches, updates := 0, 0
+
+			err := patchOnConflict(context.Background(), client.GoCD{OnConflict: test.onConflict}, "object", "sample",
+				func() (conflictObject, error) {
+					fetches++
+
+					return conflictObject{Name: "sample"}, nil
+				},
+				func(object *conflictObject) (bool, error) {
+					object.Pipelines = append(object.Pipelines, "deploy")
+
+					return test.changed, nil
+				},
+				func(object conflictObject) error {
+					updates++
+
+					return test.updateErrors[updates-1]
+				})
+
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+
+			if fetches != test.expectedFetches || updates != test.expectedUpdates {
+				t.Errorf("expected %d fetches and %d updates, got %d fetches and %d updates", test.expectedFetches, test.expectedUpdates, fetches, updates)
+			}
+		})
+	}
+}
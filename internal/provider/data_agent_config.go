@@ -2,10 +2,12 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 	"github.com/spf13/cast"
 )
@@ -121,12 +123,42 @@ func dataSourceAgentConfig() *schema.Resource {
 				ForceNew:    false,
 				Description: "The build details provides information like pipeline, stage and job if the build_state of the agent is `Building`",
 			},
+			"build_pipeline": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the pipeline of the job being built on the agent, empty when the agent is not building.",
+			},
+			"build_pipeline_counter": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The counter of the pipeline instance of the job being built on the agent, 0 when the agent is not building.",
+			},
+			"build_stage": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the stage of the job being built on the agent, empty when the agent is not building.",
+			},
+			"build_stage_counter": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The counter of the stage instance of the job being built on the agent, 0 when the agent is not building.",
+			},
+			"build_job": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the job being built on the agent, empty when the agent is not building.",
+			},
+			"build_job_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL of the job details page of the job being built on the agent, empty when the agent is not building.",
+			},
 		},
 	}
 }
 
-func datasourceAgentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func datasourceAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	id := d.Id()
 
@@ -194,13 +226,15 @@ func datasourceAgentRead(_ context.Context, d *schema.ResourceData, meta interfa
 		return diag.Errorf(settingAttrErrorTmp, err, utils.TerraformResourceBuildState)
 	}
 
-	buildDetails, err := utils.Map(response.BuildDetails)
+	buildDetails, err := flattenAgentBuildDetails(response.BuildDetails)
 	if err != nil {
 		return diag.Errorf("flattening '%s' errored with :%v", utils.TerraformResourceBuildDetails, err)
 	}
 
-	if err = d.Set(utils.TerraformResourceBuildDetails, buildDetails); err != nil {
-		return diag.Errorf(settingAttrErrorTmp, err, utils.TerraformResourceBuildDetails)
+	for key, value := range buildDetails {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
 	}
 
 	d.SetId(id)
@@ -210,7 +244,10 @@ func datasourceAgentRead(_ context.Context, d *schema.ResourceData, meta interfa
 
 func flattenEnvironments(envs any) []string {
 	envList := make([]string, 0)
-	environments := envs.([]interface{})
+	environments, ok := envs.([]interface{})
+	if !ok {
+		return envList
+	}
 
 	for _, environment := range environments {
 		newEnvironment := environment.(map[string]interface{})
@@ -219,3 +256,69 @@ func flattenEnvironments(envs any) []string {
 
 	return envList
 }
+
+// flattenAgentBuildDetails flattens the build details of the agent into the attributes of the job being built,
+// the hypermedia links are left out of build_details since they are not strings.
+func flattenAgentBuildDetails(buildDetails interface{}) (map[string]interface{}, error) {
+	var details struct {
+		Links struct {
+			Job struct {
+				Href string `json:"href"`
+			} `json:"job"`
+		} `json:"_links"`
+		PipelineName string `json:"pipeline_name"`
+		StageName    string `json:"stage_name"`
+		JobName      string `json:"job_name"`
+	}
+
+	detailsJSON, err := json.Marshal(buildDetails)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = json.Unmarshal(detailsJSON, &details); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]interface{})
+	if err = json.Unmarshal(detailsJSON, &fields); err != nil {
+		return nil, err
+	}
+
+	stringFields := make(map[string]string)
+	for key, value := range fields {
+		if stringValue, ok := value.(string); ok {
+			stringFields[key] = stringValue
+		}
+	}
+
+	pipelineCounter, stageCounter := parseJobDetailsURL(details.Links.Job.Href)
+
+	return map[string]interface{}{
+		utils.TerraformResourceBuildDetails:       stringFields,
+		utils.TerraformResourceBuildPipeline:      details.PipelineName,
+		utils.TerraformResourceBuildPipelineCount: pipelineCounter,
+		utils.TerraformResourceBuildStage:         details.StageName,
+		utils.TerraformResourceBuildStageCounter:  stageCounter,
+		utils.TerraformResourceBuildJob:           details.JobName,
+		utils.TerraformResourceBuildJobURL:        details.Links.Job.Href,
+	}, nil
+}
+
+// parseJobDetailsURL returns the pipeline and stage counters from the URL of the job details page,
+// which is of format '.../tab/build/detail/<pipeline>/<pipeline_counter>/<stage>/<stage_counter>/<job>'.
+func parseJobDetailsURL(jobURL string) (int, int) {
+	const jobDetailsPath = "/tab/build/detail/"
+
+	index := strings.Index(jobURL, jobDetailsPath)
+	if index == -1 {
+		return 0, 0
+	}
+
+	parts := strings.Split(jobURL[index+len(jobDetailsPath):], "/")
+	if len(parts) != 5 {
+		return 0, 0
+	}
+
+	return cast.ToInt(parts[1]), cast.ToInt(parts[3])
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+	"github.com/spf13/cast"
+)
+
+const (
+	defaultAgentJobHistoryLimit = 10
+	maxAgentJobHistoryLimit     = 100
+
+	jobStateScheduled = "Scheduled"
+	jobStateAssigned  = "Assigned"
+	jobStateCompleted = "Completed"
+)
+
+func dataSourceAgentJobHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAgentJobHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"uuid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The identifier of the agent whose job run history should be fetched.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         false,
+				Default:          defaultAgentJobHistoryLimit,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, maxAgentJobHistoryLimit)),
+				Description:      "The number of the most recent jobs run on the agent to be fetched, can be at most 100. Defaults to 10.",
+			},
+			"jobs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The most recent jobs run on the agent, latest first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pipeline": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the pipeline of the job.",
+						},
+						"pipeline_counter": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The counter of the pipeline instance of the job.",
+						},
+						"stage": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the stage of the job.",
+						},
+						"stage_counter": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The counter of the stage instance of the job.",
+						},
+						"job": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the job.",
+						},
+						"result": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The result of the job, one of `Passed`, `Failed`, `Cancelled` or `Unknown` (while the job is running).",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The latest state of the job, ex: `Building` or `Completed`.",
+						},
+						"rerun": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the job was run as part of a rerun of the stage.",
+						},
+						"scheduled_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time (RFC3339) at which the job was scheduled.",
+						},
+						"assigned_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time (RFC3339) at which the job was assigned to the agent.",
+						},
+						"completed_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The time (RFC3339) at which the job completed, empty while the job is running.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgentJobHistoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	uuid := utils.String(d.Get(utils.TerraformResourceUUID))
+	limit := d.Get(utils.TerraformResourceLimit).(int)
+
+	response, err := goCDClient.GetAgentJobHistory(ctx, uuid, limit)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	if len(response) > limit {
+		response = response[:limit]
+	}
+
+	jobs := make([]map[string]interface{}, 0)
+	for _, job := range response {
+		jobs = append(jobs, flattenAgentJobInstance(job))
+	}
+
+	if err = d.Set(utils.TerraformResourceJobs, jobs); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceJobs, err)
+	}
+
+	d.SetId(uuid)
+
+	return nil
+}
+
+func flattenAgentJobInstance(job client.AgentJobInstance) map[string]interface{} {
+	flattened := map[string]interface{}{
+		"pipeline":         job.PipelineName,
+		"pipeline_counter": cast.ToInt(job.PipelineCounter),
+		"stage":            job.StageName,
+		"stage_counter":    cast.ToInt(job.StageCounter),
+		"job":              job.JobName,
+		"result":           job.Result,
+		"rerun":            job.Rerun,
+		"state":            "",
+		"scheduled_time":   "",
+		"assigned_time":    "",
+		"completed_time":   "",
+	}
+
+	// transitions are in the order the job went through them, so the last one is its latest state.
+	for _, transition := range job.StateTransitions {
+		flattened["state"] = transition.State
+
+		switch transition.State {
+		case jobStateScheduled:
+			flattened["scheduled_time"] = formatTimestamp(transition.StateChangeTime)
+		case jobStateAssigned:
+			flattened["assigned_time"] = formatTimestamp(transition.StateChangeTime)
+		case jobStateCompleted:
+			flattened["completed_time"] = formatTimestamp(transition.StateChangeTime)
+		}
+	}
+
+	return flattened
+}
+
+// formatTimestamp formats the timestamp returned by GoCD server either as epoch milliseconds or as a formatted time,
+// the latter is returned as is.
+func formatTimestamp(timestamp interface{}) string {
+	switch value := timestamp.(type) {
+	case string:
+		return value
+	case float64, json.Number:
+		return formatEpochMillis(cast.ToInt64(value))
+	default:
+		return ""
+	}
+}
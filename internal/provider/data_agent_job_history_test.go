@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+func TestFormatTimestamp(t *testing.T) {
+	tests := []struct {
+		name      string
+		timestamp interface{}
+		expected  string
+	}{
+		{name: "formatted by GoCD server", timestamp: "2023-02-01T10:15:30Z", expected: "2023-02-01T10:15:30Z"},
+		{name: "epoch millis decoded as float", timestamp: float64(1675246530000), expected: "2023-02-01T10:15:30Z"},
+		{name: "epoch millis decoded as number", timestamp: json.Number("1675246530000"), expected: "2023-02-01T10:15:30Z"},
+		{name: "zero epoch millis", timestamp: float64(0), expected: ""},
+		{name: "missing", timestamp: nil, expected: ""},
+		{name: "unknown type", timestamp: true, expected: ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := formatTimestamp(test.timestamp); actual != test.expected {
+				t.Errorf("expected '%s', got '%s'", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenAgentJobInstance(t *testing.T) {
+	tests := []struct {
+		name     string
+		job      client.AgentJobInstance
+		expected map[string]interface{}
+	}{
+		{
+			name: "completed job with counters as numbers",
+			job: client.AgentJobInstance{
+				PipelineName: "build", PipelineCounter: float64(12), StageName: "test", StageCounter: float64(2), JobName: "unit",
+				Result: "Passed", Rerun: true,
+				StateTransitions: []client.AgentJobStateTransition{
+					{State: jobStateScheduled, StateChangeTime: float64(1675246500000)},
+					{State: jobStateAssigned, StateChangeTime: float64(1675246510000)},
+					{State: "Building", StateChangeTime: float64(1675246520000)},
+					{State: jobStateCompleted, StateChangeTime: float64(1675246530000)},
+				},
+			},
+			expected: map[string]interface{}{
+				"pipeline": "build", "pipeline_counter": 12, "stage": "test", "stage_counter": 2, "job": "unit",
+				"result": "Passed", "rerun": true, "state": jobStateCompleted,
+				"scheduled_time": "2023-02-01T10:15:00Z", "assigned_time": "2023-02-01T10:15:10Z", "completed_time": "2023-02-01T10:15:30Z",
+			},
+		},
+		{
+			name: "assigned job with counters as strings",
+			job: client.AgentJobInstance{
+				PipelineName: "deploy", PipelineCounter: "3", StageName: "prod", StageCounter: "1", JobName: "apply", Result: "Unknown",
+				StateTransitions: []client.AgentJobStateTransition{
+					{State: jobStateScheduled, StateChangeTime: "2023-02-01T10:15:00Z"},
+					{State: jobStateAssigned, StateChangeTime: "2023-02-01T10:15:10Z"},
+				},
+			},
+			expected: map[string]interface{}{
+				"pipeline": "deploy", "pipeline_counter": 3, "stage": "prod", "stage_counter": 1, "job": "apply",
+				"result": "Unknown", "rerun": false, "state": jobStateAssigned,
+				"scheduled_time": "2023-02-01T10:15:00Z", "assigned_time": "2023-02-01T10:15:10Z", "completed_time": "",
+			},
+		},
+		{
+			name: "job without transitions",
+			job:  client.AgentJobInstance{PipelineName: "build", JobName: "unit"},
+			expected: map[string]interface{}{
+				"pipeline": "build", "pipeline_counter": 0, "stage": "", "stage_counter": 0, "job": "unit",
+				"result": "", "rerun": false, "state": "", "scheduled_time": "", "assigned_time": "", "completed_time": "",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.expected, flattenAgentJobInstance(test.job)); diff != "" {
+				t.Errorf("unexpected job (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
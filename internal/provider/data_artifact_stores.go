@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceArtifactStores() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceArtifactStoresRead,
+		Schema:      listingSchema(utils.TerraformResourceArtifactStores, "artifact stores", true, pluginConfigListingSchema()),
+	}
+}
+
+func dataSourceArtifactStoresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetArtifactStores()
+	if err != nil {
+		return diag.Errorf("getting artifact stores errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	stores := make([]map[string]interface{}, 0)
+
+	for _, store := range response.CommonConfigs {
+		matched, err := filter.matches(store.ID, store.PluginID, store)
+		if err != nil {
+			return diag.Errorf("filtering artifact stores errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, store.ID)
+		stores = append(stores, map[string]interface{}{
+			utils.TerraformResourceID:         store.ID,
+			utils.TerraformResourcePluginID:   store.PluginID,
+			utils.TerraformResourceProperties: flattenListingProperties(store.Properties),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceArtifactStores, names, stores); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceAuthConfigs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAuthConfigsRead,
+		Schema:      listingSchema(utils.TerraformResourceAuthConfigs, "auth configs", true, pluginConfigListingSchema()),
+	}
+}
+
+func dataSourceAuthConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetAuthConfigs()
+	if err != nil {
+		return diag.Errorf("getting auth configs errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	authConfigs := make([]map[string]interface{}, 0)
+
+	for _, authConfig := range response {
+		matched, err := filter.matches(authConfig.ID, authConfig.PluginID, authConfig)
+		if err != nil {
+			return diag.Errorf("filtering auth configs errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, authConfig.ID)
+		authConfigs = append(authConfigs, map[string]interface{}{
+			utils.TerraformResourceID:         authConfig.ID,
+			utils.TerraformResourcePluginID:   authConfig.PluginID,
+			utils.TerraformResourceProperties: flattenListingProperties(authConfig.Properties),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceAuthConfigs, names, authConfigs); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceConfigRepoPreflight() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: datasourceConfigRepoPreflightRead,
+		Schema: map[string]*schema.Schema{
+			"plugin_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The config repo plugin to be used for parsing the files, ex: `yaml.config.plugin` or `json.config.plugin`.",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "Map of file names to their contents, these are the pipeline definitions that have to be validated.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"repo_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: false,
+				ForceNew: true,
+				Description: "The identifier of an existing config repository, when set the files would be validated in the context " +
+					"of this config repository (as if they were pushed to it).",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Would be set to true when the passed files were parsed by GoCD successfully.",
+			},
+			"errors": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of errors reported by GoCD while validating the files.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func datasourceConfigRepoPreflightRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	pluginID := utils.String(d.Get(utils.TerraformResourcePluginID))
+	repoID := utils.String(d.Get(utils.TerraformResourceRepoID))
+	files := getPreflightFiles(d.Get(utils.TerraformResourceFiles))
+
+	// only the result of the validation by the plugin is set, every other failure of the check is an error.
+	result, err := goCDClient.ConfigRepoPreflightCheck(ctx, pluginID, repoID, files)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	if err = d.Set(utils.TerraformResourceValid, result.Valid); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceValid, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceErrors, flattenPreflightErrors(result.Errors)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceErrors, err)
+	}
+
+	id, err := getPreflightID(pluginID, repoID, files)
+	if err != nil {
+		return diag.Errorf("computing ID for preflight check errored with: %v", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func getPreflightFiles(configs interface{}) map[string]string {
+	files := make(map[string]string)
+	for name, fileContent := range configs.(map[string]interface{}) {
+		files[name] = utils.String(fileContent)
+	}
+
+	return files
+}
+
+func flattenPreflightErrors(errors []string) []string {
+	preflightErrors := make([]string, 0, len(errors))
+	for _, preflightError := range errors {
+		if preflightError = strings.TrimSpace(preflightError); len(preflightError) != 0 {
+			preflightErrors = append(preflightErrors, preflightError)
+		}
+	}
+
+	return preflightErrors
+}
+
+// getPreflightID returns an ID that changes whenever any of the inputs passed for the preflight check changes.
+func getPreflightID(pluginID, repoID string, files map[string]string) (string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var preflightInput strings.Builder
+	preflightInput.WriteString(pluginID + "\n" + repoID + "\n")
+	for _, name := range names {
+		preflightInput.WriteString(name + "\n" + files[name] + "\n")
+	}
+
+	return utils.GetChecksum(preflightInput.String())
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceConfigRepositories() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceConfigRepositoriesRead,
+		Schema:      listingSchema(utils.TerraformResourceConfigRepositories, "config repositories", true, pluginConfigListingSchema()),
+	}
+}
+
+func dataSourceConfigRepositoriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetConfigRepos()
+	if err != nil {
+		return diag.Errorf("getting config repositories errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	repos := make([]map[string]interface{}, 0)
+
+	for _, repo := range response {
+		matched, err := filter.matches(repo.ID, repo.PluginID, repo)
+		if err != nil {
+			return diag.Errorf("filtering config repositories errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, repo.ID)
+		repos = append(repos, map[string]interface{}{
+			utils.TerraformResourceID:         repo.ID,
+			utils.TerraformResourcePluginID:   repo.PluginID,
+			utils.TerraformResourceProperties: flattenListingProperties(repo.Configuration),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceConfigRepositories, names, repos); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceElasticAgentProfiles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceElasticAgentProfilesRead,
+		Schema:      listingSchema(utils.TerraformResourceElasticProfiles, "elastic agent profiles", true, elasticAgentProfileListingSchema()),
+	}
+}
+
+func dataSourceElasticAgentProfilesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetElasticAgentProfiles()
+	if err != nil {
+		return diag.Errorf("getting elastic agent profiles errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	profiles := make([]map[string]interface{}, 0)
+
+	for _, profile := range response.CommonConfigs {
+		matched, err := filter.matches(profile.ID, profile.PluginID, profile)
+		if err != nil {
+			return diag.Errorf("filtering elastic agent profiles errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, profile.ID)
+		profiles = append(profiles, map[string]interface{}{
+			utils.TerraformResourceID:               profile.ID,
+			utils.TerraformResourcePluginID:         profile.PluginID,
+			utils.TerraformResourceClusterProfileID: profile.ClusterProfileID,
+			utils.TerraformResourceProperties:       flattenListingProperties(profile.Properties),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceElasticProfiles, names, profiles); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
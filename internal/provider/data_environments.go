@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceEnvironments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceEnvironmentsRead,
+		Schema: listingSchema(utils.TerraformResourceEnvironments, "environments", false, map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the environment.",
+			},
+			"pipelines": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the pipelines that are part of the environment.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+func dataSourceEnvironmentsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetEnvironments()
+	if err != nil {
+		return diag.Errorf("getting environments errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	environments := make([]map[string]interface{}, 0)
+
+	for _, environment := range response {
+		matched, err := filter.matches(environment.Name, "", environment)
+		if err != nil {
+			return diag.Errorf("filtering environments errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, environment.Name)
+		environments = append(environments, map[string]interface{}{
+			utils.TerraformResourceName:      environment.Name,
+			utils.TerraformResourcePipelines: getPipelineNames(environment.Pipelines),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceEnvironments, names, environments); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const defaultArtifactMaxSize = 1024 * 1024
+
+func dataSourceJobArtifact() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceJobArtifactRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the pipeline whose job uploaded the artifact.",
+			},
+			"pipeline_counter": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description: "The counter of the pipeline instance, when not set the latest instance in which the stage passed is used " +
+					"(among the 100 most recent instances).",
+			},
+			"stage": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the stage whose job uploaded the artifact.",
+			},
+			"stage_counter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The counter of the stage, when not set the latest run of the stage in the pipeline instance is used.",
+			},
+			"job": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the job that uploaded the artifact.",
+			},
+			"path": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Computed:         false,
+				ValidateDiagFunc: validation.ToDiagFunc(validateArtifactPath),
+				Description: "The path of the artifact file relative to the artifacts of the job, ex: `build/image-digest.txt`. " +
+					"It cannot have empty, `.` or `..` segments.",
+			},
+			"max_size": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         false,
+				Default:          defaultArtifactMaxSize,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "The max size of the artifact in bytes, reading the data source fails when the artifact is larger. Defaults to 1 MiB.",
+			},
+			"content": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content of the artifact, set only when it is valid UTF-8 text. Use `content_base64` for binary artifacts.",
+			},
+			"content_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The content of the artifact encoded in base64.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The size of the artifact in bytes.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hex encoded SHA256 checksum of the artifact.",
+			},
+		},
+	}
+}
+
+func dataSourceJobArtifactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	artifact := client.Artifact{
+		Pipeline:        utils.String(d.Get(utils.TerraformResourcePipeline)),
+		PipelineCounter: d.Get(utils.TerraformResourcePipelineCounter).(int),
+		Stage:           utils.String(d.Get(utils.TerraformResourceStage)),
+		StageCounter:    utils.String(d.Get(utils.TerraformResourceStageCounter)),
+		Job:             utils.String(d.Get(utils.TerraformResourceJob)),
+		Path:            utils.String(d.Get(utils.TerraformResourcePath)),
+	}
+
+	if err := resolveArtifactCounters(ctx, goCDClient, &artifact); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	content, err := goCDClient.DownloadArtifact(ctx, artifact, int64(d.Get(utils.TerraformResourceMaxSize).(int)))
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	checksum := sha256.Sum256(content)
+
+	textContent := ""
+	if utf8.Valid(content) {
+		textContent = string(content)
+	}
+
+	jobArtifact := map[string]interface{}{
+		utils.TerraformResourcePipelineCounter: artifact.PipelineCounter,
+		utils.TerraformResourceStageCounter:    artifact.StageCounter,
+		utils.TerraformResourceContent:         textContent,
+		utils.TerraformResourceContentBase64:   base64.StdEncoding.EncodeToString(content),
+		utils.TerraformResourceSize:            len(content),
+		utils.TerraformResourceSHA256:          hex.EncodeToString(checksum[:]),
+	}
+
+	for key, value := range jobArtifact {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	d.SetId(artifact.String())
+
+	return nil
+}
+
+// resolveArtifactCounters sets the pipeline counter to the latest instance in which the stage passed and the stage counter
+// to the latest run of the stage in the pipeline instance, when they are not set.
+func resolveArtifactCounters(ctx context.Context, goCDClient client.GoCD, artifact *client.Artifact) error {
+	if artifact.PipelineCounter == 0 {
+		instances, err := goCDClient.GetPipelineHistory(ctx, artifact.Pipeline, maxPipelineHistoryLimit)
+		if err != nil {
+			return fmt.Errorf("getting run history of pipeline '%s' errored with: %w", artifact.Pipeline, err)
+		}
+
+		for _, instance := range instances {
+			if stage, found := getPipelineInstanceStage(instance, artifact.Stage); found && stage.Result == pipelineResultPassed {
+				artifact.PipelineCounter = instance.Counter
+				if len(artifact.StageCounter) == 0 {
+					artifact.StageCounter = stage.Counter
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("stage '%s' of pipeline '%s' has not passed in its %d most recent runs", artifact.Stage, artifact.Pipeline, maxPipelineHistoryLimit)
+	}
+
+	if len(artifact.StageCounter) != 0 {
+		return nil
+	}
+
+	instance, err := goCDClient.GetPipelineInstance(ctx, artifact.Pipeline, artifact.PipelineCounter)
+	if err != nil {
+		return fmt.Errorf("getting instance '%d' of pipeline '%s' errored with: %w", artifact.PipelineCounter, artifact.Pipeline, err)
+	}
+
+	stage, found := getPipelineInstanceStage(instance, artifact.Stage)
+	if !found || !stage.Scheduled {
+		return fmt.Errorf("stage '%s' has not run in instance '%d' of pipeline '%s'", artifact.Stage, artifact.PipelineCounter, artifact.Pipeline)
+	}
+
+	artifact.StageCounter = stage.Counter
+
+	return nil
+}
+
+func getPipelineInstanceStage(instance client.PipelineInstance, name string) (client.PipelineStageInstance, bool) {
+	for _, stage := range instance.Stages {
+		if stage.Name == name {
+			return stage, true
+		}
+	}
+
+	return client.PipelineStageInstance{}, false
+}
+
+func validateArtifactPath(value interface{}, key string) ([]string, []error) {
+	path, ok := value.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", key)}
+	}
+
+	if err := client.ValidateArtifactPath(path); err != nil {
+		return nil, []error{fmt.Errorf("%s: %w", key, err)}
+	}
+
+	return nil, nil
+}
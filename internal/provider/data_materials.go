@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceMaterials() *schema.Resource {
+	materials := listingSchema(utils.TerraformResourceMaterials, "materials", false, map[string]*schema.Schema{
+		"fingerprint": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The fingerprint of the material.",
+		},
+		"type": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The type of the material, ex: `git` or `dependency`.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the material, empty when it is not set.",
+		},
+		"url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The url of the material, empty for the materials without one (ex: dependency).",
+		},
+		"branch": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The branch of the material, empty for the materials without one.",
+		},
+		"can_trigger_update": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the update of the material can be triggered by the user of the provider.",
+		},
+		"update_in_progress": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether GoCD is updating (polling) the material now.",
+		},
+		"revision": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The revision of the last modification of the material.",
+		},
+		"modified_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The user who made the last modification of the material.",
+		},
+		"modified_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The time of the last modification of the material.",
+		},
+		"comment": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The comment of the last modification of the material, ex: the commit message.",
+		},
+		"errors": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The errors GoCD ran into while updating the material, ex: authentication failures.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	})
+
+	return &schema.Resource{
+		ReadContext: dataSourceMaterialsRead,
+		Schema:      materials,
+	}
+}
+
+func dataSourceMaterialsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := goCDClient.GetMaterials(ctx)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	names := make([]string, 0)
+	materials := make([]map[string]interface{}, 0)
+
+	for _, material := range response {
+		matched, err := filter.matches(material.Config.Fingerprint, "", material)
+		if err != nil {
+			return diag.Errorf("filtering materials errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, material.Config.Fingerprint)
+		materials = append(materials, map[string]interface{}{
+			"fingerprint":        material.Config.Fingerprint,
+			"type":               material.Config.Type,
+			"name":               material.Config.Attributes.Name,
+			"url":                material.Config.Attributes.URL,
+			"branch":             material.Config.Attributes.Branch,
+			"can_trigger_update": material.CanTriggerUpdate,
+			"update_in_progress": material.MaterialUpdateInProgress,
+			"revision":           material.Modification.Revision,
+			"modified_by":        material.Modification.UserName,
+			"modified_time":      material.Modification.ModifiedTime,
+			"comment":            material.Modification.Comment,
+			"errors":             flattenMaterialErrors(material.Messages),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceMaterials, names, materials); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
+
+func flattenMaterialErrors(messages []map[string]string) []string {
+	materialErrors := make([]string, 0)
+	for _, message := range messages {
+		materialError := message[utils.TerraformResourceMessage]
+		if description := message["description"]; len(description) != 0 {
+			materialError = strings.TrimSpace(materialError + ": " + description)
+		}
+
+		materialErrors = append(materialErrors, materialError)
+	}
+
+	return materialErrors
+}
+
+// getMaterial returns the material with the fingerprint from the materials known to GoCD.
+func getMaterial(materials []gocd.Material, fingerprint string) (gocd.Material, bool) {
+	for _, material := range materials {
+		if material.Config.Fingerprint == fingerprint {
+			return material, true
+		}
+	}
+
+	return gocd.Material{}, false
+}
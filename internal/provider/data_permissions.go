@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePermissions() *schema.Resource {
+	permissions := map[string]*schema.Schema{
+		"types": {
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: false,
+			Elem: &schema.Schema{
+				Type:             schema.TypeString,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(client.PermissionEntityTypes, false)),
+			},
+			Description: "The types of the entities whose permissions should be fetched, should be any of `environment`, `config_repo`, " +
+				"`cluster_profile`, `elastic_agent_profile` or `pipeline_group`. Permissions of all the types are fetched when not set.",
+		},
+	}
+
+	for _, entityType := range client.PermissionEntityTypes {
+		permissions[entityType] = &schema.Schema{
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The " + strings.ReplaceAll(entityType, "_", " ") + "s which the user can view and administer.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"view": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "The names of the entities which the user can view.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+					"administer": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "The names of the entities which the user can administer.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePermissionsRead,
+		Schema:      permissions,
+	}
+}
+
+func dataSourcePermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := checkFeature(ctx, meta, client.FeaturePermissions); diags.HasError() {
+		return diags
+	}
+
+	entityTypes := utils.GetSlice(d.Get(utils.TerraformResourceTypes).([]interface{}))
+	if len(entityTypes) == 0 {
+		entityTypes = client.PermissionEntityTypes
+	}
+
+	response, err := meta.(client.GoCD).GetPermissions(ctx, entityTypes)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	for _, entityType := range client.PermissionEntityTypes {
+		permissions := make([]map[string]interface{}, 0)
+		if utils.Contains(entityTypes, entityType) {
+			permissions = append(permissions, map[string]interface{}{
+				utils.TerraformResourceView:       response[entityType].View,
+				utils.TerraformResourceAdminister: response[entityType].Administer,
+			})
+		}
+
+		if err = d.Set(entityType, permissions); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, entityType, err)
+		}
+	}
+
+	d.SetId(strings.Join(entityTypes, ","))
+
+	return nil
+}
@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const dependencyMaterialType = "dependency"
+
+// pipelineDependency is a dependency material of the downstream pipeline on the stage of the upstream pipeline.
+type pipelineDependency struct {
+	upstream   string
+	stage      string
+	downstream string
+}
+
+func dataSourcePipelineDependencies() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePipelineDependenciesRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				Description: "The name of the pipeline whose upstream and downstream pipelines should be computed.",
+			},
+			"edges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The dependency materials of all the pipelines, from the upstream to the downstream pipelines.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"upstream": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the upstream pipeline.",
+						},
+						"stage": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The stage of the upstream pipeline on which the downstream pipeline depends.",
+						},
+						"downstream": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the downstream pipeline.",
+						},
+					},
+				},
+			},
+			"upstream": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pipelines on which the pipeline directly depends, set only when `pipeline` is set.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"downstream": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pipelines that directly depend on the pipeline, set only when `pipeline` is set.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"all_upstream": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pipelines on which the pipeline depends directly or transitively, set only when `pipeline` is set.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"all_downstream": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Description: "The pipelines that depend on the pipeline directly or transitively, set only when `pipeline` is set. " +
+					"These are orphaned when the pipeline is deleted.",
+				Elem: &schema.Schema{Type: schema.TypeString},
+			},
+			"cycles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The cycles in the dependencies between the pipelines, ex: `a -> b -> a`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"orphaned_pipelines": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pipelines that depend on the pipelines which do not exist.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourcePipelineDependenciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	pipelineConfigs, err := meta.(client.GoCD).GetPipelineConfigs(ctx)
+	if err != nil {
+		return diag.Errorf("getting pipeline configurations errored with: %v", err)
+	}
+
+	pipelines := make([]string, 0, len(pipelineConfigs))
+	dependencies := make([]pipelineDependency, 0)
+
+	for _, pipelineCfg := range pipelineConfigs {
+		pipelines = append(pipelines, pipelineCfg.Name)
+		dependencies = append(dependencies, getPipelineDependencies(pipelineCfg)...)
+	}
+
+	sort.Strings(pipelines)
+	sort.SliceStable(dependencies, func(i, j int) bool {
+		if dependencies[i].upstream != dependencies[j].upstream {
+			return dependencies[i].upstream < dependencies[j].upstream
+		}
+
+		return dependencies[i].downstream < dependencies[j].downstream
+	})
+
+	upstreamOf := make(map[string][]string)
+	downstreamOf := make(map[string][]string)
+	edges := make([]map[string]interface{}, 0)
+	orphaned := make([]string, 0)
+
+	for _, dependency := range dependencies {
+		upstreamOf[dependency.downstream] = appendUnique(upstreamOf[dependency.downstream], dependency.upstream)
+		downstreamOf[dependency.upstream] = appendUnique(downstreamOf[dependency.upstream], dependency.downstream)
+		edges = append(edges, map[string]interface{}{
+			"upstream":   dependency.upstream,
+			"stage":      dependency.stage,
+			"downstream": dependency.downstream,
+		})
+
+		if !utils.Contains(pipelines, dependency.upstream) {
+			orphaned = appendUnique(orphaned, dependency.downstream)
+		}
+	}
+
+	sort.Strings(orphaned)
+
+	pipelineDependencies := map[string]interface{}{
+		utils.TerraformResourceEdges:             edges,
+		utils.TerraformResourceCycles:            findDependencyCycles(pipelines, downstreamOf),
+		utils.TerraformResourceOrphanedPipelines: orphaned,
+	}
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+	if len(pipeline) != 0 {
+		pipelineDependencies[utils.TerraformResourceUpstream] = upstreamOf[pipeline]
+		pipelineDependencies[utils.TerraformResourceDownstream] = downstreamOf[pipeline]
+		pipelineDependencies[utils.TerraformResourceAllUpstream] = getTransitiveDependencies(pipeline, upstreamOf)
+		pipelineDependencies[utils.TerraformResourceAllDownstream] = getTransitiveDependencies(pipeline, downstreamOf)
+	}
+
+	for key, value := range pipelineDependencies {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	if len(pipeline) == 0 {
+		pipeline = "pipeline_dependencies"
+	}
+
+	d.SetId(pipeline)
+
+	return nil
+}
+
+// getPipelineDependencies returns the dependency materials of the pipeline, config is not typed by gocd-sdk-go so it is read from the map.
+func getPipelineDependencies(pipelineCfg gocd.PipelineConfig) []pipelineDependency {
+	dependencies := make([]pipelineDependency, 0)
+
+	materials, _ := pipelineCfg.Config["materials"].([]interface{})
+	for _, material := range materials {
+		materialCfg, _ := material.(map[string]interface{})
+		if materialCfg["type"] != dependencyMaterialType {
+			continue
+		}
+
+		attributes, _ := materialCfg["attributes"].(map[string]interface{})
+		upstream, _ := attributes["pipeline"].(string)
+		stage, _ := attributes["stage"].(string)
+
+		if len(upstream) == 0 {
+			continue
+		}
+
+		dependencies = append(dependencies, pipelineDependency{upstream: upstream, stage: stage, downstream: pipelineCfg.Name})
+	}
+
+	return dependencies
+}
+
+// getTransitiveDependencies returns the sorted pipelines reachable from the pipeline through the graph passed, excluding itself.
+func getTransitiveDependencies(pipeline string, graph map[string][]string) []string {
+	visited := map[string]bool{pipeline: true}
+	pending := append([]string{}, graph[pipeline]...)
+	dependencies := make([]string, 0)
+
+	for len(pending) != 0 {
+		current := pending[0]
+		pending = pending[1:]
+
+		if visited[current] {
+			continue
+		}
+
+		visited[current] = true
+		dependencies = append(dependencies, current)
+		pending = append(pending, graph[current]...)
+	}
+
+	sort.Strings(dependencies)
+
+	return dependencies
+}
+
+// findDependencyCycles finds the cycles in the graph with a depth first search, every cycle is reported once
+// starting from the pipeline with the smallest name in it.
+func findDependencyCycles(pipelines []string, downstreamOf map[string][]string) []string {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+
+	state := make(map[string]int)
+	cycles := make([]string, 0)
+	path := make([]string, 0)
+
+	var visit func(pipeline string)
+	visit = func(pipeline string) {
+		state[pipeline] = inProgress
+		path = append(path, pipeline)
+
+		for _, downstream := range downstreamOf[pipeline] {
+			switch state[downstream] {
+			case unvisited:
+				visit(downstream)
+			case inProgress:
+				cycle := normaliseCycle(path[indexOf(path, downstream):])
+				if !utils.Contains(cycles, cycle) {
+					cycles = append(cycles, cycle)
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[pipeline] = done
+	}
+
+	for _, pipeline := range pipelines {
+		if state[pipeline] == unvisited {
+			visit(pipeline)
+		}
+	}
+
+	sort.Strings(cycles)
+
+	return cycles
+}
+
+func normaliseCycle(cycle []string) string {
+	start := 0
+	for index, pipeline := range cycle {
+		if pipeline < cycle[start] {
+			start = index
+		}
+	}
+
+	rotated := append(append([]string{}, cycle[start:]...), cycle[:start]...)
+
+	return strings.Join(append(rotated, rotated[0]), " -> ")
+}
+
+func indexOf(slice []string, value string) int {
+	for index, element := range slice {
+		if element == value {
+			return index
+		}
+	}
+
+	return -1
+}
+
+func appendUnique(slice []string, value string) []string {
+	if utils.Contains(slice, value) {
+		return slice
+	}
+
+	return append(slice, value)
+}
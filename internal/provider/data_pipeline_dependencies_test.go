@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestFindDependencyCycles(t *testing.T) {
+	tests := []struct {
+		name         string
+		pipelines    []string
+		downstreamOf map[string][]string
+		expected     []string
+	}{
+		{
+			name:         "no dependencies",
+			pipelines:    []string{"build", "deploy"},
+			downstreamOf: map[string][]string{},
+			expected:     []string{},
+		},
+		{
+			name:         "chain and diamond without cycles",
+			pipelines:    []string{"build", "test", "lint", "deploy"},
+			downstreamOf: map[string][]string{"build": {"test", "lint"}, "test": {"deploy"}, "lint": {"deploy"}},
+			expected:     []string{},
+		},
+		{
+			name:         "pipeline depending on itself",
+			pipelines:    []string{"build"},
+			downstreamOf: map[string][]string{"build": {"build"}},
+			expected:     []string{"build -> build"},
+		},
+		{
+			name:         "cycle is reported once starting from the smallest pipeline",
+			pipelines:    []string{"deploy", "build", "test"},
+			downstreamOf: map[string][]string{"deploy": {"build"}, "build": {"test"}, "test": {"deploy"}},
+			expected:     []string{"build -> test -> deploy -> build"},
+		},
+		{
+			name:      "separate cycles are sorted",
+			pipelines: []string{"a", "b", "c", "d", "e"},
+			downstreamOf: map[string][]string{
+				"a": {"b"}, "b": {"a", "c"}, "c": {"d"}, "d": {"e"}, "e": {"c"},
+			},
+			expected: []string{"a -> b -> a", "c -> d -> e -> c"},
+		},
+		{
+			name:         "downstream pipelines missing from the pipelines are still visited",
+			pipelines:    []string{"build"},
+			downstreamOf: map[string][]string{"build": {"test"}, "test": {"build"}},
+			expected:     []string{"build -> test -> build"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diff := cmp.Diff(test.expected, findDependencyCycles(test.pipelines, test.downstreamOf)); diff != "" {
+				t.Errorf("unexpected cycles (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetPipelineDependencies(t *testing.T) {
+	pipelineCfg := gocd.PipelineConfig{
+		Name: "deploy",
+		Config: map[string]interface{}{
+			"materials": []interface{}{
+				map[string]interface{}{"type": "git", "attributes": map[string]interface{}{"url": "https://github.com/gocd/gocd"}},
+				map[string]interface{}{"type": "dependency", "attributes": map[string]interface{}{"pipeline": "build", "stage": "package"}},
+				map[string]interface{}{"type": "dependency", "attributes": map[string]interface{}{"stage": "test"}},
+			},
+		},
+	}
+
+	expected := []pipelineDependency{{upstream: "build", stage: "package", downstream: "deploy"}}
+	if diff := cmp.Diff(expected, getPipelineDependencies(pipelineCfg), cmp.AllowUnexported(pipelineDependency{})); diff != "" {
+		t.Errorf("unexpected dependencies (-want +got):\n%s", diff)
+	}
+}
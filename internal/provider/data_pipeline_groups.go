@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePipelineGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePipelineGroupsRead,
+		Schema: listingSchema(utils.TerraformResourcePipelineGroups, "pipeline groups", false, map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the pipeline group.",
+			},
+			"pipelines": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The names of the pipelines that are part of the pipeline group.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+func dataSourcePipelineGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetPipelineGroups()
+	if err != nil {
+		return diag.Errorf("getting pipeline groups errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	groups := make([]map[string]interface{}, 0)
+
+	for _, group := range response {
+		matched, err := filter.matches(group.Name, "", group)
+		if err != nil {
+			return diag.Errorf("filtering pipeline groups errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, group.Name)
+		groups = append(groups, map[string]interface{}{
+			utils.TerraformResourceName:      group.Name,
+			utils.TerraformResourcePipelines: getPipelineNames(group.Pipelines),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourcePipelineGroups, names, groups); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
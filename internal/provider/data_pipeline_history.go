@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	defaultPipelineHistoryLimit = 10
+	maxPipelineHistoryLimit     = 100
+
+	pipelineResultPassed    = "Passed"
+	pipelineResultFailed    = "Failed"
+	pipelineResultCancelled = "Cancelled"
+	pipelineResultBuilding  = "Building"
+	pipelineResultUnknown   = "Unknown"
+)
+
+func dataSourcePipelineHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePipelineHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the pipeline whose run history should be fetched.",
+			},
+			"limit": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         false,
+				Default:          defaultPipelineHistoryLimit,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntBetween(1, maxPipelineHistoryLimit)),
+				Description:      "The number of the most recent pipeline instances to be fetched, can be at most 100. Defaults to 10.",
+			},
+			"latest_counter": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The counter of the most recent pipeline instance, 0 when the pipeline never ran.",
+			},
+			"latest_result": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The overall result of the most recent pipeline instance, empty when the pipeline never ran.",
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The most recent pipeline instances, latest first.",
+				Elem:        &schema.Resource{Schema: pipelineInstanceSchema()},
+			},
+		},
+	}
+}
+
+func dataSourcePipelineHistoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+	limit := d.Get(utils.TerraformResourceLimit).(int)
+
+	response, err := goCDClient.GetPipelineHistory(ctx, pipeline, limit)
+	if err != nil {
+		return diag.Errorf("getting run history of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	if len(response) > limit {
+		response = response[:limit]
+	}
+
+	instances := make([]map[string]interface{}, 0)
+	for _, instance := range response {
+		instances = append(instances, flattenPipelineInstance(instance))
+	}
+
+	latestCounter, latestResult := 0, ""
+	if len(response) != 0 {
+		latestCounter, latestResult = response[0].Counter, getPipelineInstanceResult(response[0])
+	}
+
+	pipelineHistory := map[string]interface{}{
+		utils.TerraformResourceInstances:     instances,
+		utils.TerraformResourceLatestCounter: latestCounter,
+		utils.TerraformResourceLatestResult:  latestResult,
+	}
+
+	for key, value := range pipelineHistory {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", pipeline, latestCounter))
+
+	return nil
+}
+
+func flattenPipelineInstance(instance client.PipelineInstance) map[string]interface{} {
+	stages := make([]map[string]interface{}, 0)
+	for _, stage := range instance.Stages {
+		jobs := make([]map[string]interface{}, 0)
+		for _, job := range stage.Jobs {
+			jobs = append(jobs, map[string]interface{}{
+				"name":           job.Name,
+				"state":          job.State,
+				"result":         job.Result,
+				"scheduled_time": formatEpochMillis(job.ScheduledDate),
+			})
+		}
+
+		stages = append(stages, map[string]interface{}{
+			"name":        stage.Name,
+			"counter":     stage.Counter,
+			"scheduled":   stage.Scheduled,
+			"status":      stage.Status,
+			"result":      stage.Result,
+			"approved_by": stage.ApprovedBy,
+			"jobs":        jobs,
+		})
+	}
+
+	materialRevisions := make([]map[string]interface{}, 0)
+	for _, materialRevision := range instance.BuildCause.MaterialRevisions {
+		revision := map[string]interface{}{
+			"name":        materialRevision.Material.Name,
+			"type":        materialRevision.Material.Type,
+			"description": materialRevision.Material.Description,
+			"fingerprint": materialRevision.Material.Fingerprint,
+			"changed":     materialRevision.Changed,
+		}
+
+		// modifications are listed latest first, only the latest one is the revision with which the pipeline ran.
+		if len(materialRevision.Modifications) != 0 {
+			modification := materialRevision.Modifications[0]
+			revision["revision"] = modification.Revision
+			revision["modified_by"] = modification.UserName
+			revision["modified_time"] = formatEpochMillis(modification.ModifiedTime)
+			revision["comment"] = modification.Comment
+		}
+
+		materialRevisions = append(materialRevisions, revision)
+	}
+
+	return map[string]interface{}{
+		"counter":            instance.Counter,
+		"label":              instance.Label,
+		"scheduled_time":     formatEpochMillis(instance.ScheduledDate),
+		"triggered_by":       instance.BuildCause.Approver,
+		"trigger_message":    instance.BuildCause.TriggerMessage,
+		"forced":             instance.BuildCause.TriggerForced,
+		"result":             getPipelineInstanceResult(instance),
+		"stages":             stages,
+		"material_revisions": materialRevisions,
+	}
+}
+
+// getPipelineInstanceResult derives the overall result of the pipeline instance, it is Passed only when all of its stages passed.
+func getPipelineInstanceResult(instance client.PipelineInstance) string {
+	if len(instance.Stages) == 0 {
+		return pipelineResultUnknown
+	}
+
+	passed := true
+
+	for _, stage := range instance.Stages {
+		switch {
+		case stage.Result == pipelineResultFailed:
+			return pipelineResultFailed
+		case stage.Result == pipelineResultCancelled:
+			return pipelineResultCancelled
+		case stage.Status == pipelineResultBuilding:
+			return pipelineResultBuilding
+		case stage.Result != pipelineResultPassed:
+			passed = false
+		}
+	}
+
+	if passed {
+		return pipelineResultPassed
+	}
+
+	return pipelineResultUnknown
+}
+
+func formatEpochMillis(millis int64) string {
+	if millis == 0 {
+		return ""
+	}
+
+	return time.UnixMilli(millis).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,72 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePipelineInstance() *schema.Resource {
+	pipelineInstance := pipelineInstanceSchema()
+	pipelineInstance["pipeline"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Computed:    false,
+		Description: "The name of the pipeline whose instance should be fetched.",
+	}
+	pipelineInstance["counter"] = &schema.Schema{
+		Type:             schema.TypeInt,
+		Optional:         true,
+		Computed:         true,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+		Description:      "The counter of the pipeline instance to be fetched, the most recent instance is fetched when not set.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePipelineInstanceRead,
+		Schema:      pipelineInstance,
+	}
+}
+
+func dataSourcePipelineInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	var instance client.PipelineInstance
+
+	if counter, ok := d.GetOk(utils.TerraformResourceCounter); ok {
+		response, err := goCDClient.GetPipelineInstance(ctx, pipeline, counter.(int))
+		if err != nil {
+			return diag.Errorf("getting instance '%d' of pipeline '%s' errored with: %v", counter.(int), pipeline, err)
+		}
+
+		instance = response
+	} else {
+		response, err := goCDClient.GetPipelineHistory(ctx, pipeline, 1)
+		if err != nil {
+			return diag.Errorf("getting the latest instance of pipeline '%s' errored with: %v", pipeline, err)
+		}
+
+		if len(response) == 0 {
+			return diag.Errorf("pipeline '%s' has not run yet", pipeline)
+		}
+
+		instance = response[0]
+	}
+
+	for key, value := range flattenPipelineInstance(instance) {
+		if err := d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", pipeline, instance.Counter))
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePipelineStatus() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePipelineStatusRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the pipeline whose status should be fetched.",
+			},
+			"paused": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the pipeline is paused.",
+			},
+			"paused_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The user who paused the pipeline, empty when the pipeline is not paused.",
+			},
+			"pause_reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason given while pausing the pipeline, empty when the pipeline is not paused.",
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the pipeline is locked, i.e. a run of the pipeline configured with a lock behavior is in progress.",
+			},
+			"schedulable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the pipeline can be scheduled (triggered) now.",
+			},
+		},
+	}
+}
+
+func dataSourcePipelineStatusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	response, err := defaultConfig.GetPipelineState(pipeline)
+	if err != nil {
+		return diag.Errorf("getting status of pipeline '%s' errored with: %v", pipeline, err)
+	}
+
+	pipelineStatus := map[string]interface{}{
+		utils.TerraformResourcePaused:      response.Paused,
+		utils.TerraformResourcePausedBy:    response.PausedBy,
+		utils.TerraformResourcePauseReason: response.PausedCause,
+		utils.TerraformResourceLocked:      response.Locked,
+		utils.TerraformResourceSchedulable: response.Schedulable,
+	}
+
+	for key, value := range pipelineStatus {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	d.SetId(pipeline)
+
+	return nil
+}
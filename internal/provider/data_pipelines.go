@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourcePipelines() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePipelinesRead,
+		Schema: listingSchema(utils.TerraformResourcePipelines, "pipelines", false, map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the pipeline.",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the pipeline group the pipeline is part of.",
+			},
+		}),
+	}
+}
+
+func dataSourcePipelinesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	// pipelines are listed from the pipeline groups, which avoids fetching the config of every pipeline.
+	response, err := defaultConfig.GetPipelineGroups()
+	if err != nil {
+		return diag.Errorf("getting pipeline groups errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	pipelines := make([]map[string]interface{}, 0)
+
+	for _, group := range response {
+		for _, pipeline := range group.Pipelines {
+			flattenedPipeline := map[string]interface{}{
+				utils.TerraformResourceName:  pipeline.Name,
+				utils.TerraformResourceGroup: group.Name,
+			}
+
+			matched, err := filter.matches(pipeline.Name, "", flattenedPipeline)
+			if err != nil {
+				return diag.Errorf("filtering pipelines errored with: %v", err)
+			}
+
+			if !matched {
+				continue
+			}
+
+			names = append(names, pipeline.Name)
+			pipelines = append(pipelines, flattenedPipeline)
+		}
+	}
+
+	if err = setListing(d, utils.TerraformResourcePipelines, names, pipelines); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
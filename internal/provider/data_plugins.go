@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const pluginStatusActive = "active"
+
+var (
+	pluginExtensionTypes = []string{
+		"authorization", "elastic-agent", "artifact", "secrets", "configrepo", "scm", "package-repository", "task", "notification", "analytics",
+	}
+	pluginStatuses = []string{pluginStatusActive, "invalid"}
+)
+
+func dataSourcePlugins() *schema.Resource {
+	plugins := listingSchema(utils.TerraformResourcePlugins, "plugins", false, pluginListingSchema())
+	plugins["extension"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         false,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(pluginExtensionTypes, false)),
+		Description: "Lists only the plugins implementing the extension of this type, should be one of " +
+			"`authorization`, `elastic-agent`, `artifact`, `secrets`, `configrepo`, `scm`, `package-repository`, `task`, `notification` or `analytics`.",
+	}
+	plugins["status"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		Computed:         false,
+		ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(pluginStatuses, false)),
+		Description:      "Lists only the plugins with this status, should be one of `active` or `invalid`.",
+	}
+	plugins["required_plugins"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: false,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+		Description: "IDs of the plugins that should be installed and active on GoCD server, reading the data source fails when any of them " +
+			"is missing or invalid. It is checked against all the installed plugins irrespective of the filters.",
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourcePluginsRead,
+		Schema:      plugins,
+	}
+}
+
+func dataSourcePluginsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetPluginsInfo()
+	if err != nil {
+		return diag.Errorf("getting plugins information errored with: %v", err)
+	}
+
+	if err = validateRequiredPlugins(response.Plugins, utils.GetSlice(d.Get(utils.TerraformResourceRequiredPlugins).([]interface{}))); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	extension := utils.String(d.Get(utils.TerraformResourceExtension))
+	status := utils.String(d.Get(utils.TerraformResourcePluginStatus))
+
+	names := make([]string, 0)
+	plugins := make([]map[string]interface{}, 0)
+
+	for _, plugin := range response.Plugins {
+		extensionTypes := getPluginExtensionTypes(plugin)
+		if len(extension) != 0 && !utils.Contains(extensionTypes, extension) {
+			continue
+		}
+
+		if len(status) != 0 && !strings.EqualFold(plugin.Status.State, status) {
+			continue
+		}
+
+		matched, err := filter.matches(plugin.ID, "", plugin)
+		if err != nil {
+			return diag.Errorf("filtering plugins errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, plugin.ID)
+		plugins = append(plugins, map[string]interface{}{
+			utils.TerraformResourceID:             plugin.ID,
+			utils.TerraformResourcePluginStatus:   plugin.Status.State,
+			utils.TerraformResourcePluginBundled:  plugin.BundledPlugin,
+			utils.TerraformResourceVersion:        getPluginVersion(plugin),
+			utils.TerraformResourcePluginLocation: plugin.PluginFileLocation,
+			utils.TerraformResourceExtensions:     extensionTypes,
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourcePlugins, names, plugins); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
+
+// validateRequiredPlugins errors listing all the required plugins that are either not installed or not active.
+func validateRequiredPlugins(plugins []*gocd.Plugin, required []string) error {
+	statuses := make(map[string]string)
+	for _, plugin := range plugins {
+		statuses[plugin.ID] = plugin.Status.State
+	}
+
+	problems := make([]string, 0)
+
+	for _, pluginID := range required {
+		status, found := statuses[pluginID]
+
+		switch {
+		case !found:
+			problems = append(problems, fmt.Sprintf("'%s' is not installed", pluginID))
+		case !strings.EqualFold(status, pluginStatusActive):
+			problems = append(problems, fmt.Sprintf("'%s' is %s", pluginID, status))
+		}
+	}
+
+	if len(problems) != 0 {
+		return fmt.Errorf("required plugins are not available on GoCD server: %s", strings.Join(problems, ", "))
+	}
+
+	return nil
+}
+
+func getPluginExtensionTypes(plugin *gocd.Plugin) []string {
+	extensionTypes := make([]string, 0)
+	for _, extension := range plugin.Extensions {
+		if !utils.Contains(extensionTypes, extension.Type) {
+			extensionTypes = append(extensionTypes, extension.Type)
+		}
+	}
+
+	return extensionTypes
+}
+
+func getPluginVersion(plugin *gocd.Plugin) string {
+	if version, ok := plugin.About[utils.TerraformResourceVersion]; ok && version != nil {
+		return fmt.Sprintf("%v", version)
+	}
+
+	return ""
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceRoles() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRolesRead,
+		Schema: listingSchema(utils.TerraformResourceRoles, "roles", false, map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the role.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the role, either `gocd` or `plugin`.",
+			},
+			"auth_config_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The authorization configuration identifier of the roles of type `plugin`.",
+			},
+			"users": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The users of the roles of type `gocd`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"properties": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The properties of the roles of type `plugin`, values of the secure properties are left out.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		}),
+	}
+}
+
+func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetRoles()
+	if err != nil {
+		return diag.Errorf("getting roles errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	roles := make([]map[string]interface{}, 0)
+
+	for _, role := range response.Role {
+		matched, err := filter.matches(role.Name, "", role)
+		if err != nil {
+			return diag.Errorf("filtering roles errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, role.Name)
+		roles = append(roles, map[string]interface{}{
+			utils.TerraformResourceName:         role.Name,
+			utils.TerraformResourceType:         role.Type,
+			utils.TerraformResourceAuthConfigID: role.Attributes.AuthConfigID,
+			utils.TerraformResourceUsers:        role.Attributes.Users,
+			utils.TerraformResourceProperties:   flattenListingProperties(role.Attributes.Properties),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceRoles, names, roles); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
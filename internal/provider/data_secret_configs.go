@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceSecretConfigs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSecretConfigsRead,
+		Schema:      listingSchema(utils.TerraformResourceSecretConfigs, "secret configs", true, pluginConfigListingSchema()),
+	}
+}
+
+func dataSourceSecretConfigsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	filter, err := getListingFilter(d)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	response, err := defaultConfig.GetSecretConfigs()
+	if err != nil {
+		return diag.Errorf("getting secret configs errored with: %v", err)
+	}
+
+	names := make([]string, 0)
+	secretConfigs := make([]map[string]interface{}, 0)
+
+	for _, secretConfig := range response.CommonConfigs {
+		matched, err := filter.matches(secretConfig.ID, secretConfig.PluginID, secretConfig)
+		if err != nil {
+			return diag.Errorf("filtering secret configs errored with: %v", err)
+		}
+
+		if !matched {
+			continue
+		}
+
+		names = append(names, secretConfig.ID)
+		secretConfigs = append(secretConfigs, map[string]interface{}{
+			utils.TerraformResourceID:         secretConfig.ID,
+			utils.TerraformResourcePluginID:   secretConfig.PluginID,
+			utils.TerraformResourceProperties: flattenListingProperties(secretConfig.Properties),
+		})
+	}
+
+	if err = setListing(d, utils.TerraformResourceSecretConfigs, names, secretConfigs); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return nil
+}
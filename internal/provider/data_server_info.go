@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceServerInfo() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServerInfoRead,
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of GoCD server, ex: `23.1.0`.",
+			},
+			"build_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The build number of GoCD server.",
+			},
+			"git_sha": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The git SHA of the commit from which GoCD server was built.",
+			},
+			"full_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of GoCD server along with its build number.",
+			},
+			"commit_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The url of the commit from which GoCD server was built.",
+			},
+			"health": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The health of GoCD server as reported by its health check, ex: `OK`.",
+			},
+			"maintenance_mode": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether GoCD server is in maintenance mode.",
+			},
+		},
+	}
+}
+
+func dataSourceServerInfoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+	defaultConfig := goCDClient.WithContext(ctx)
+
+	// the version known to the provider lacks the build, so it is fetched here along with the build.
+	versionInfo, err := goCDClient.GetServerVersionDetails(ctx)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	health, err := defaultConfig.GetServerHealth()
+	if err != nil {
+		return diag.Errorf("getting health of GoCD server errored with: %v", err)
+	}
+
+	serverInfo := map[string]interface{}{
+		utils.TerraformResourceVersion:     versionInfo.Version,
+		utils.TerraformResourceBuildNumber: versionInfo.BuildNumber,
+		utils.TerraformResourceGitSHA:      versionInfo.GitSHA,
+		utils.TerraformResourceFullVersion: versionInfo.FullVersion,
+		utils.TerraformResourceCommitURL:   versionInfo.CommitURL,
+		utils.TerraformResourceHealth:      health["health"],
+	}
+
+	if diags := checkFeature(ctx, meta, client.FeatureMaintenanceMode); !diags.HasError() {
+		maintenance, err := goCDClient.GetMaintenanceModeInfo(ctx)
+		if err != nil {
+			return diag.Errorf("%v", err)
+		}
+
+		serverInfo[utils.TerraformResourceMaintenanceMode] = maintenance.Enabled
+	}
+
+	for key, value := range serverInfo {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	id := versionInfo.FullVersion
+	if len(id) == 0 {
+		id = versionInfo.Version
+	}
+
+	d.SetId(id)
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceServerReady() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceServerReadyRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(defaultResourceTimeout),
+		},
+		Schema: serverReadySchema(),
+	}
+}
+
+func dataSourceServerReadyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if diags := setServerReady(ctx, d, meta, d.Timeout(schema.TimeoutRead)); diags.HasError() {
+		return diags
+	}
+
+	d.SetId("server_ready")
+
+	return nil
+}
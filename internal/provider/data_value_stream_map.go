@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func dataSourceValueStreamMap() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceValueStreamMapRead,
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the pipeline whose value stream map should be fetched.",
+			},
+			"counter": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Computed:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				Description:      "The counter of the pipeline instance, the most recent instance is used when not set.",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The pipelines and the materials that are part of the value stream map.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the node, name for the pipelines and fingerprint for the materials.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the pipeline or the material (ex: its url).",
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the node, `PIPELINE` for the pipelines and the type of the material (ex: `GIT`) for the materials.",
+						},
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The level of the node in the value stream map, starting from 0 for the materials farthest upstream.",
+						},
+						"parents": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The identifiers of the upstream nodes.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"dependents": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The identifiers of the downstream nodes.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"counters": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The counters of the instances of the pipeline that are part of the value stream map.",
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+			"edges": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The edges of the value stream map from the upstream to the downstream nodes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the upstream node.",
+						},
+						"to": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The identifier of the downstream node.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceValueStreamMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	counter := d.Get(utils.TerraformResourceCounter).(int)
+	if counter == 0 {
+		response, err := goCDClient.GetPipelineHistory(ctx, pipeline, 1)
+		if err != nil {
+			return diag.Errorf("getting the latest instance of pipeline '%s' errored with: %v", pipeline, err)
+		}
+
+		if len(response) == 0 {
+			return diag.Errorf("pipeline '%s' has not run yet", pipeline)
+		}
+
+		counter = response[0].Counter
+	}
+
+	valueStreamMap, err := goCDClient.GetValueStreamMap(ctx, pipeline, counter)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	nodes := make([]map[string]interface{}, 0)
+	edges := make([]map[string]interface{}, 0)
+
+	for depth, level := range valueStreamMap.Levels {
+		for _, node := range level.Nodes {
+			counters := make([]int, 0)
+			for _, instance := range node.Instances {
+				counters = append(counters, instance.Counter)
+			}
+
+			nodes = append(nodes, map[string]interface{}{
+				"id":         node.ID,
+				"name":       node.Name,
+				"type":       node.NodeType,
+				"depth":      depth,
+				"parents":    node.Parents,
+				"dependents": node.Dependents,
+				"counters":   counters,
+			})
+
+			for _, dependent := range node.Dependents {
+				edges = append(edges, map[string]interface{}{"from": node.ID, "to": dependent})
+			}
+		}
+	}
+
+	valueStream := map[string]interface{}{
+		utils.TerraformResourceCounter: counter,
+		utils.TerraformResourceNodes:   nodes,
+		utils.TerraformResourceEdges:   edges,
+	}
+
+	for key, value := range valueStream {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", pipeline, counter))
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+// checkFeature returns an error diagnostic when the feature is not supported by the version of GoCD server the provider is connected to.
+// The version of GoCD server is fetched on the first check when it was not while configuring the provider (ex: `skip_check` is set).
+func checkFeature(ctx context.Context, meta interface{}, feature client.Feature) diag.Diagnostics {
+	goCDClient, ok := meta.(client.GoCD)
+	if !ok {
+		return nil
+	}
+
+	var unsupportedErr client.UnsupportedFeatureError
+	if err := goCDClient.CheckFeature(ctx, feature); errors.As(err, &unsupportedErr) {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary: fmt.Sprintf("%s require GoCD %s or later, GoCD server is running %s",
+				feature.Name, feature.MinVersion, unsupportedErr.ServerVersion),
+			Detail: fmt.Sprintf("%v, upgrade GoCD server or remove the configuration using it.", err),
+		}}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+func TestCheckFeature(t *testing.T) {
+	tests := []struct {
+		name            string
+		meta            interface{}
+		expectedSummary string
+	}{
+		{
+			name:            "summary names the minimum version and the version of GoCD server",
+			meta:            client.GoCD{VersionInfo: &gocd.VersionInfo{Version: "19.3.0"}},
+			expectedSummary: "secret configs require GoCD 19.6.0 or later, GoCD server is running 19.3.0",
+		},
+		{
+			name: "supported feature",
+			meta: client.GoCD{VersionInfo: &gocd.VersionInfo{Version: "23.1.0"}},
+		},
+		{
+			name: "meta that is not GoCD client",
+			meta: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := checkFeature(context.Background(), test.meta, client.FeatureSecretConfigs)
+
+			switch {
+			case len(test.expectedSummary) == 0 && len(diags) != 0:
+				t.Errorf("expected no diagnostics, got: %v", diags)
+			case len(test.expectedSummary) != 0 && (len(diags) != 1 || diags[0].Summary != test.expectedSummary):
+				t.Errorf("expected diagnostic '%s', got: %v", test.expectedSummary, diags)
+			}
+		})
+	}
+}
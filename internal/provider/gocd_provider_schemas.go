@@ -1,8 +1,29 @@
 package provider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"fmt"
+	"path"
+	"strings"
+	"time"
 
-var settingAttrErrorTmp = "setting '%s' errored with '%v'"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const defaultResourceTimeout = 5 * time.Minute
+
+var (
+	settingAttrErrorTmp = "setting '%s' errored with '%v'"
+	policyDirectives    = []string{"allow", "deny"}
+	// the roles control the administration of the entities, while the rules control which entities can refer to a config repo or secret config.
+	rolePolicyActions     = []string{"view", "administer"}
+	rolePolicyTypes       = []string{"*", "environment", "config_repo", "cluster_profile", "elastic_agent_profile", "pipeline_group"}
+	ruleActions           = []string{"refer"}
+	configRepoRuleTypes   = []string{"*", "pipeline_group", "pipeline", "environment"}
+	secretConfigRuleTypes = []string{"*", "pipeline_group", "environment", "pluggable_scm", "package_repository", "cluster_profile"}
+)
 
 func configRepoSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
@@ -137,10 +158,12 @@ func configRepoSchema() map[string]*schema.Schema {
 
 func environmentsSchemaResource() *schema.Schema {
 	return &schema.Schema{
-		Type:        schema.TypeSet,
-		Computed:    false,
-		Optional:    true,
-		Description: "The list of environment variables that will be passed to all tasks (commands) that are part of this environment.",
+		Type:     schema.TypeSet,
+		Computed: false,
+		Optional: true,
+		Set:      hashEnvironmentVariable,
+		Description: "The list of environment variables that will be passed to all tasks (commands) that are part of this environment. " +
+			"Only the variables declared here are managed, the rest of the variables of the environment are left untouched.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"name": {
@@ -150,15 +173,18 @@ func environmentsSchemaResource() *schema.Schema {
 					Description: "The name of the environment variable.",
 				},
 				"value": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Computed:    false,
-					Description: "The value of the environment variable. You MUST specify one of value or encrypted_value.",
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         false,
+					Sensitive:        true,
+					DiffSuppressFunc: suppressSecureValueDiff,
+					Description: "The value of the environment variable. You MUST specify one of value or encrypted_value. " +
+						"Value of the secure variable is sent to GoCD only while writing, only its checksum is stored in the state.",
 				},
 				"encrypted_value": {
 					Type:        schema.TypeString,
 					Optional:    true,
-					Computed:    false,
+					Computed:    true,
 					Description: "The encrypted value of the environment variable. You MUST specify one of value or encrypted_value.",
 				},
 				"secure": {
@@ -167,6 +193,38 @@ func environmentsSchemaResource() *schema.Schema {
 					Computed:    false,
 					Description: "Whether environment variable is secure or not. When set to true, encrypts the value if one is specified. The default value is false.",
 				},
+				"value_checksum": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Checksum of the plain text value of the secure environment variable, used to detect the changes made to value.",
+				},
+			},
+		},
+	}
+}
+
+func secureVariablesSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The name of the secure environment variable.",
+				},
+				"encrypted_value": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "The encrypted value of the secure environment variable as stored in GoCD.",
+				},
+				"value_checksum": {
+					Type:        schema.TypeString,
+					Computed:    true,
+					Description: "Checksum of the plain text value of the secure environment variable, used to detect the changes made to value.",
+				},
 			},
 		},
 	}
@@ -509,6 +567,75 @@ func rulesSchema() *schema.Resource {
 	}
 }
 
+// policySchema is the typed schema shared by the policy of the roles and the rules of the config repositories and secret configs,
+// directive is the name of the attribute holding allow/deny, which is `permission` for policy and `directive` for rules.
+// actions and types are the ones supported by GoCD for the consumer of the schema.
+func policySchema(directive string, actions, types []string) *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			directive: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(policyDirectives, false)),
+				Description:      "Whether the access to the resource is allowed or denied, should be one of `allow` or `deny`.",
+			},
+			"action": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(actions, false)),
+				Description:      fmt.Sprintf("The action that is being controlled, should be %s.", getOneOf(actions)),
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(types, false)),
+				Description:      fmt.Sprintf("The type of entity that the action is controlled on, should be %s, where `*` is for all the types.", getOneOf(types)),
+			},
+			"resource": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validatePolicyResource,
+				Description:      "The entity on which the action is controlled, the name of the entity or a glob pattern which matches one or more entities.",
+			},
+		},
+	}
+}
+
+// getOneOf lists the values for the descriptions, ex: one of `view` or `administer`.
+func getOneOf(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, "`"+value+"`")
+	}
+
+	if len(quoted) == 1 {
+		return quoted[0]
+	}
+
+	return "one of " + strings.Join(quoted[:len(quoted)-1], ", ") + " or " + quoted[len(quoted)-1]
+}
+
+func validatePolicyResource(value interface{}, attrPath cty.Path) diag.Diagnostics {
+	resource, ok := value.(string)
+	if !ok || len(resource) == 0 {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       "resource of the policy cannot be empty",
+			AttributePath: attrPath,
+		}}
+	}
+
+	if _, err := path.Match(resource, ""); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("resource '%s' of the policy is not a valid glob pattern: %v", resource, err),
+			AttributePath: attrPath,
+		}}
+	}
+
+	return nil
+}
+
 func authConfigSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -589,3 +716,381 @@ func retrySchemas() *schema.Schema {
 		},
 	}
 }
+
+func serverReadySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"min_interval": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         false,
+			ForceNew:         true,
+			Default:          defaultServerReadyMinInterval,
+			ValidateDiagFunc: validateDuration,
+			Description:      "Interval before the first retry when GoCD server is not ready, it is doubled on every retry up to `max_interval`. Defaults to `2s`.",
+		},
+		"max_interval": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         false,
+			ForceNew:         true,
+			Default:          defaultServerReadyMaxInterval,
+			ValidateDiagFunc: validateDuration,
+			Description:      "Maximum interval between the retries made while waiting for GoCD server to be ready. Defaults to `30s`.",
+		},
+		"health": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The health of GoCD server as reported by its health check, ex: `OK`.",
+		},
+		"messages": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The health messages of GoCD server (warnings, since it waits until the errors are cleared).",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"level": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The level of the health message, ex: `WARNING`.",
+					},
+					"message": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The health message.",
+					},
+					"detail": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The details of the health message.",
+					},
+					"time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The time at which the health message was raised.",
+					},
+				},
+			},
+		},
+	}
+}
+
+// listingSchema returns the schema of the plural data sources, with the filters and the objects listed under the key passed.
+func listingSchema(key, kind string, withPluginID bool, elem map[string]*schema.Schema) map[string]*schema.Schema {
+	listing := map[string]*schema.Schema{
+		"name_regex": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         false,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			Description:      fmt.Sprintf("Regex to filter the %s by their names.", kind),
+		},
+		"filter": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Computed:    false,
+			Description: fmt.Sprintf("Filters the %s by their attributes, the %s matching all the filters are listed.", kind, kind),
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Required: true,
+						Computed: false,
+						Description: "The name of the attribute (nested attributes by their own name, ex: `url` of the material) " +
+							"or the key of the property to filter by.",
+					},
+					"values": {
+						Type:        schema.TypeList,
+						Required:    true,
+						Computed:    false,
+						Description: "The values to filter by, the attribute matching any of them is considered a match.",
+						Elem:        &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			},
+		},
+		"names": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("The sorted names of the %s matching the filters.", kind),
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		key: {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: fmt.Sprintf("The %s matching the filters.", kind),
+			Elem:        &schema.Resource{Schema: elem},
+		},
+	}
+
+	if withPluginID {
+		listing["plugin_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    false,
+			Description: fmt.Sprintf("Lists only the %s of the plugin with this ID.", kind),
+		}
+	}
+
+	return listing
+}
+
+// pluginConfigListingSchema returns the schema of the plugin backed configs listed by the plural data sources.
+func pluginConfigListingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identifier of the config.",
+		},
+		"plugin_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The identifier of the plugin to which the config belongs.",
+		},
+		"properties": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Description: "The properties of the config, values of the secure properties are left out.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+func elasticAgentProfileListingSchema() map[string]*schema.Schema {
+	elasticAgentProfile := pluginConfigListingSchema()
+	elasticAgentProfile["cluster_profile_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The identifier of the cluster profile to which the elastic agent profile belongs.",
+	}
+
+	return elasticAgentProfile
+}
+
+// pluginListingSchema returns the schema of the plugins listed by the data source gocd_plugins.
+func pluginListingSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique plugin identifier.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Status of the plugin. Can be one of active, invalid.",
+		},
+		"bundled_plugin": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Indicates whether the plugin is bundled with GoCD.",
+		},
+		"version": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The version of the plugin.",
+		},
+		"plugin_file_location": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The location where the plugin is installed.",
+		},
+		"extensions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The types of the extensions the plugin implements.",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+	}
+}
+
+// pipelineInstanceSchema returns the schema of a run (instance) of the pipeline, shared by gocd_pipeline_history and gocd_pipeline_instance.
+func pipelineInstanceSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"counter": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The counter of the pipeline instance.",
+		},
+		"label": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The label of the pipeline instance.",
+		},
+		"scheduled_time": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The time (RFC3339) at which the pipeline instance was scheduled.",
+		},
+		"triggered_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The user who triggered the pipeline instance, `changes` when it was triggered by the changes in the materials.",
+		},
+		"trigger_message": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The message describing what triggered the pipeline instance.",
+		},
+		"forced": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the pipeline instance was triggered forcefully (manually).",
+		},
+		"result": {
+			Type:     schema.TypeString,
+			Computed: true,
+			Description: "The overall result of the pipeline instance derived from its stages, one of `Passed` (all stages passed), " +
+				"`Failed`, `Cancelled`, `Building` or `Unknown` (ex: stages waiting on manual approval).",
+		},
+		"stages": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The stages of the pipeline instance.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the stage.",
+					},
+					"counter": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The counter of the stage, it is incremented on rerunning the stage.",
+					},
+					"scheduled": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the stage was scheduled.",
+					},
+					"status": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The status of the stage, ex: `Building`, `Passed` or `Failed`.",
+					},
+					"result": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The result of the stage, ex: `Passed`, `Failed`, `Cancelled` or `Unknown`.",
+					},
+					"approved_by": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The user who approved the stage, `changes` when it was approved automatically.",
+					},
+					"jobs": {
+						Type:        schema.TypeList,
+						Computed:    true,
+						Description: "The jobs of the stage.",
+						Elem: &schema.Resource{
+							Schema: map[string]*schema.Schema{
+								"name": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The name of the job.",
+								},
+								"state": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The state of the job, ex: `Scheduled`, `Building` or `Completed`.",
+								},
+								"result": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The result of the job, ex: `Passed`, `Failed`, `Cancelled` or `Unknown`.",
+								},
+								"scheduled_time": {
+									Type:        schema.TypeString,
+									Computed:    true,
+									Description: "The time (RFC3339) at which the job was scheduled.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		"material_revisions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "The revisions of the materials with which the pipeline instance ran.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The name of the material.",
+					},
+					"type": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The type of the material, ex: `Git` or `Pipeline`.",
+					},
+					"description": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The description of the material, ex: its url and branch.",
+					},
+					"fingerprint": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The fingerprint of the material.",
+					},
+					"changed": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Whether the material changed since the previous run of the pipeline.",
+					},
+					"revision": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The latest revision of the material with which the pipeline instance ran.",
+					},
+					"modified_by": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The user who made the latest modification of the material.",
+					},
+					"modified_time": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The time (RFC3339) of the latest modification of the material.",
+					},
+					"comment": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The comment of the latest modification of the material, ex: the commit message.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func validateDuration(value interface{}, attrPath cty.Path) diag.Diagnostics {
+	if _, err := time.ParseDuration(value.(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity:      diag.Error,
+			Summary:       fmt.Sprintf("'%s' is not a valid duration: %v", value, err),
+			AttributePath: attrPath,
+		}}
+	}
+
+	return nil
+}
+
+// resourceTimeouts returns the configurable timeouts of the resources, update timeout is set only on the resources that support updates.
+func resourceTimeouts(withUpdate bool) *schema.ResourceTimeout {
+	timeouts := &schema.ResourceTimeout{
+		Create: schema.DefaultTimeout(defaultResourceTimeout),
+		Read:   schema.DefaultTimeout(defaultResourceTimeout),
+		Delete: schema.DefaultTimeout(defaultResourceTimeout),
+	}
+
+	if withUpdate {
+		timeouts.Update = schema.DefaultTimeout(defaultResourceTimeout)
+	}
+
+	return timeouts
+}
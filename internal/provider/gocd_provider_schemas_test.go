@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestPolicySchema(t *testing.T) {
+	tests := []struct {
+		name      string
+		resource  *schema.Resource
+		attribute string
+		directive string
+		action    string
+		entity    string
+		expectErr bool
+	}{
+		{name: "role policy on environments", resource: resourceRole(), attribute: "policy", directive: "permission", action: "administer", entity: "environment"},
+		{name: "role policy on pipeline groups", resource: resourceRole(), attribute: "policy", directive: "permission", action: "view", entity: "pipeline_group"},
+		{name: "role policy cannot refer", resource: resourceRole(), attribute: "policy", directive: "permission", action: "refer", entity: "environment", expectErr: true},
+		{name: "role policy on pipelines", resource: resourceRole(), attribute: "policy", directive: "permission", action: "view", entity: "pipeline", expectErr: true},
+		{
+			name: "role policy on pluggable scms", resource: resourceRole(), attribute: "policy", directive: "permission", action: "view",
+			entity: "pluggable_scm", expectErr: true,
+		},
+		{
+			name: "role policy on package repositories", resource: resourceRole(), attribute: "policy", directive: "permission", action: "view",
+			entity: "package_repository", expectErr: true,
+		},
+		{name: "config repo rule referring pipelines", resource: resourceConfigRepository(), attribute: "rules", directive: "directive", action: "refer", entity: "pipeline"},
+		{
+			name: "config repo rule administering pipeline groups", resource: resourceConfigRepository(), attribute: "rules", directive: "directive",
+			action: "administer", entity: "pipeline_group", expectErr: true,
+		},
+		{
+			name: "config repo rule referring cluster profiles", resource: resourceConfigRepository(), attribute: "rules", directive: "directive",
+			action: "refer", entity: "cluster_profile", expectErr: true,
+		},
+		{name: "secret config rule referring all the types", resource: resourceSecretConfig(), attribute: "rules", directive: "directive", action: "refer", entity: "*"},
+		{
+			name: "secret config rule viewing environments", resource: resourceSecretConfig(), attribute: "rules", directive: "directive",
+			action: "view", entity: "environment", expectErr: true,
+		},
+		{
+			name: "secret config rule referring config repos", resource: resourceSecretConfig(), attribute: "rules", directive: "directive",
+			action: "refer", entity: "config_repo", expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			policy := test.resource.Schema[test.attribute].Elem.(*schema.Resource)
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				test.directive: "allow", "action": test.action, "type": test.entity, "resource": "*",
+			})
+
+			if diags := policy.Validate(config); diags.HasError() != test.expectErr {
+				t.Errorf("expected error: %t, got: %v", test.expectErr, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+// listingFilter holds the filters set on the plural data sources, an object is listed only when it matches all of them.
+type listingFilter struct {
+	nameRegex  *regexp.Regexp
+	pluginID   string
+	attributes map[string][]string
+}
+
+func getListingFilter(d *schema.ResourceData) (listingFilter, error) {
+	filter := listingFilter{attributes: make(map[string][]string)}
+
+	if nameRegex := utils.String(d.Get(utils.TerraformResourceNameRegex)); len(nameRegex) != 0 {
+		compiled, err := regexp.Compile(nameRegex)
+		if err != nil {
+			return filter, fmt.Errorf("compiling '%s' errored with: %w", utils.TerraformResourceNameRegex, err)
+		}
+
+		filter.nameRegex = compiled
+	}
+
+	if pluginID, ok := d.GetOk(utils.TerraformResourcePluginID); ok {
+		filter.pluginID = utils.String(pluginID)
+	}
+
+	for _, attributeFilter := range d.Get(utils.TerraformResourceFilter).(*schema.Set).List() {
+		attribute := attributeFilter.(map[string]interface{})
+		name := utils.String(attribute[utils.TerraformResourceName])
+		for _, value := range attribute[utils.TerraformResourceValues].([]interface{}) {
+			filter.attributes[name] = append(filter.attributes[name], utils.String(value))
+		}
+	}
+
+	return filter, nil
+}
+
+// matches reports whether the object with the name and plugin id passed matches the filters. Attribute filters are matched against
+// the attributes of the object (nested attributes by their own name) and the keys of its properties, any of the values has to match.
+func (filter listingFilter) matches(name, pluginID string, object interface{}) (bool, error) {
+	if filter.nameRegex != nil && !filter.nameRegex.MatchString(name) {
+		return false, nil
+	}
+
+	if len(filter.pluginID) != 0 && filter.pluginID != pluginID {
+		return false, nil
+	}
+
+	if len(filter.attributes) == 0 {
+		return true, nil
+	}
+
+	attributes, err := getListingAttributes(object)
+	if err != nil {
+		return false, err
+	}
+
+	for name, values := range filter.attributes {
+		if !containsAny(attributes[name], values) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// getListingAttributes flattens the object to the values of its attributes keyed by their names, properties (key/value pairs) are keyed
+// by their keys and the objects with a name (ex: pipelines of an environment) by the name of the attribute holding them.
+// Values of the secure properties are left out.
+func getListingAttributes(object interface{}) (map[string][]string, error) {
+	objectJSON, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded interface{}
+	if err = json.Unmarshal(objectJSON, &decoded); err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string][]string)
+	flattenListingAttributes("", decoded, attributes)
+
+	return attributes, nil
+}
+
+func flattenListingAttributes(name string, value interface{}, attributes map[string][]string) {
+	switch object := value.(type) {
+	case map[string]interface{}:
+		if key, ok := object["key"].(string); ok {
+			// gocd-sdk-go marshals the secure flag of the properties either as `secure` or as `is_secure` depending on its version.
+			secure, _ := object["secure"].(bool)
+			isSecure, _ := object["is_secure"].(bool)
+
+			if !secure && !isSecure {
+				flattenListingAttributes(key, object["value"], attributes)
+			}
+
+			return
+		}
+
+		if nestedName, ok := object[utils.TerraformResourceName].(string); ok && len(name) != 0 {
+			attributes[name] = append(attributes[name], nestedName)
+
+			return
+		}
+
+		for key, nested := range object {
+			flattenListingAttributes(key, nested, attributes)
+		}
+	case []interface{}:
+		for _, nested := range object {
+			flattenListingAttributes(name, nested, attributes)
+		}
+	case nil:
+		return
+	default:
+		attributes[name] = append(attributes[name], fmt.Sprintf("%v", object))
+	}
+}
+
+func containsAny(values, expected []string) bool {
+	for _, value := range expected {
+		if utils.Contains(values, value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setListing sets the names and the objects listed by the plural data sources, ID is the checksum of the names so that it is stable.
+func setListing(d *schema.ResourceData, key string, names []string, objects []map[string]interface{}) error {
+	sort.Strings(names)
+	sort.SliceStable(objects, func(i, j int) bool {
+		return listingName(objects[i]) < listingName(objects[j])
+	})
+
+	if err := d.Set(utils.TerraformResourceNames, names); err != nil {
+		return fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceNames, err)
+	}
+
+	if err := d.Set(key, objects); err != nil {
+		return fmt.Errorf(settingAttrErrorTmp, key, err)
+	}
+
+	checksum, err := utils.GetChecksum(key + ":" + strings.Join(names, ","))
+	if err != nil {
+		return fmt.Errorf("computing ID of %s errored with: %w", key, err)
+	}
+
+	d.SetId(checksum)
+
+	return nil
+}
+
+// listingName returns the name by which the object is listed, materials do not have a unique name so they are listed by their fingerprints.
+func listingName(object map[string]interface{}) string {
+	for _, key := range []string{utils.TerraformResourceFingerprint, utils.TerraformResourceName} {
+		if name, ok := object[key]; ok {
+			return utils.String(name)
+		}
+	}
+
+	return utils.String(object[utils.TerraformResourceID])
+}
+
+// flattenListingProperties flattens the properties to a map, values of the secure properties are left out.
+func flattenListingProperties(properties []gocd.PluginConfiguration) map[string]interface{} {
+	flattenedProperties := make(map[string]interface{})
+	for _, property := range properties {
+		if property.IsSecure {
+			continue
+		}
+
+		flattenedProperties[property.Key] = property.Value
+	}
+
+	return flattenedProperties
+}
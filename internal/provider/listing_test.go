@@ -0,0 +1,149 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestGetListingFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		raw       map[string]interface{}
+		pluginID  string
+		nameRegex string
+		expected  map[string][]string
+		expectErr bool
+	}{
+		{name: "no filters", raw: map[string]interface{}{}, expected: map[string][]string{}},
+		{
+			name: "all the filters",
+			raw: map[string]interface{}{
+				"name_regex": "^prod-",
+				"plugin_id":  "cd.go.contrib.elasticagent.kubernetes",
+				"filter": []interface{}{
+					map[string]interface{}{"name": "Namespace", "values": []interface{}{"prod", "staging"}},
+				},
+			},
+			pluginID:  "cd.go.contrib.elasticagent.kubernetes",
+			nameRegex: "^prod-",
+			expected:  map[string][]string{"Namespace": {"prod", "staging"}},
+		},
+		{name: "invalid name regex", raw: map[string]interface{}{"name_regex": "prod-("}, expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, dataSourceClusterProfiles().Schema, test.raw)
+
+			filter, err := getListingFilter(d)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+
+			if test.expectErr {
+				return
+			}
+
+			if filter.pluginID != test.pluginID {
+				t.Errorf("expected plugin id '%s', got '%s'", test.pluginID, filter.pluginID)
+			}
+
+			if (filter.nameRegex == nil && len(test.nameRegex) != 0) || (filter.nameRegex != nil && filter.nameRegex.String() != test.nameRegex) {
+				t.Errorf("expected name regex '%s', got: %v", test.nameRegex, filter.nameRegex)
+			}
+
+			if diff := cmp.Diff(test.expected, filter.attributes); diff != "" {
+				t.Errorf("unexpected attribute filters (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGetListingAttributes(t *testing.T) {
+	object := map[string]interface{}{
+		"id":        "k8s",
+		"plugin_id": "cd.go.contrib.elasticagent.kubernetes",
+		"pipelines": []interface{}{map[string]interface{}{"name": "build"}, map[string]interface{}{"name": "deploy"}},
+		"properties": []interface{}{
+			map[string]interface{}{"key": "Namespace", "value": "prod"},
+			map[string]interface{}{"key": "Token", "value": "secret", "secure": true},
+			map[string]interface{}{"key": "Password", "value": "secret", "is_secure": true},
+		},
+		"attributes": map[string]interface{}{"auto_update": true, "branch": nil},
+	}
+
+	expected := map[string][]string{
+		"id":          {"k8s"},
+		"plugin_id":   {"cd.go.contrib.elasticagent.kubernetes"},
+		"pipelines":   {"build", "deploy"},
+		"Namespace":   {"prod"},
+		"auto_update": {"true"},
+	}
+
+	attributes, err := getListingAttributes(object)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(expected, attributes); diff != "" {
+		t.Errorf("unexpected attributes (-want +got):\n%s", diff)
+	}
+}
+
+func TestListingFilterMatches(t *testing.T) {
+	environment := gocd.Environment{Name: "prod-eu", Pipelines: []gocd.Pipeline{{Name: "deploy"}, {Name: "smoke"}}}
+
+	tests := []struct {
+		name     string
+		raw      map[string]interface{}
+		pluginID string
+		expected bool
+	}{
+		{name: "no filters", raw: map[string]interface{}{}, expected: true},
+		{name: "name matching the regex", raw: map[string]interface{}{"name_regex": "^prod-"}, expected: true},
+		{name: "name not matching the regex", raw: map[string]interface{}{"name_regex": "^dev-"}},
+		{name: "plugin id matching", raw: map[string]interface{}{"plugin_id": "cd.go.secrets.file"}, pluginID: "cd.go.secrets.file", expected: true},
+		{name: "plugin id not matching", raw: map[string]interface{}{"plugin_id": "cd.go.secrets.file"}, pluginID: "cd.go.secrets.vault"},
+		{
+			name: "any of the values of the attribute matching",
+			raw: map[string]interface{}{"filter": []interface{}{
+				map[string]interface{}{"name": "pipelines", "values": []interface{}{"build", "smoke"}},
+			}},
+			expected: true,
+		},
+		{
+			name: "all the attributes have to match",
+			raw: map[string]interface{}{"filter": []interface{}{
+				map[string]interface{}{"name": "pipelines", "values": []interface{}{"smoke"}},
+				map[string]interface{}{"name": "name", "values": []interface{}{"prod-us"}},
+			}},
+		},
+		{
+			name: "attribute the object does not have",
+			raw: map[string]interface{}{"filter": []interface{}{
+				map[string]interface{}{"name": "plugin_id", "values": []interface{}{"cd.go.secrets.file"}},
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filter, err := getListingFilter(schema.TestResourceDataRaw(t, dataSourceClusterProfiles().Schema, test.raw))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			matched, err := filter.matches(environment.Name, test.pluginID, environment)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if matched != test.expected {
+				t.Errorf("expected matched: %t, got: %t", test.expected, matched)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+// checkPermission returns a CustomizeDiffFunc which fails the plan when `check_permissions` is set on the provider and the user configured
+// on the provider cannot administer the entity of the type named by the attribute key, instead of the apply failing half-way.
+//
+// When the entity is the one managed by the resource (managed is set), it is checked only when the resource already exists,
+// since GoCD reports the permissions only of the existing entities. Entity types which GoCD does not report (ex: roles) can be
+// administered only by the system admins, so they are checked even for the resources yet to be created.
+func checkPermission(entityType, key string, managed bool) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		goCDClient, ok := meta.(client.GoCD)
+		if !ok || !goCDClient.CheckPermissions {
+			return nil
+		}
+
+		reported := utils.Contains(client.PermissionEntityTypes, entityType)
+		if (managed && reported && len(d.Id()) == 0) || !d.NewValueKnown(key) {
+			return nil
+		}
+
+		name := utils.String(d.Get(key))
+		if len(name) == 0 {
+			return nil
+		}
+
+		if reported {
+			if err := goCDClient.CheckFeature(ctx, client.FeaturePermissions); err != nil {
+				tflog.Warn(ctx, "skipping the permission check", map[string]interface{}{"reason": err.Error()})
+
+				return nil
+			}
+		}
+
+		canView, canAdminister, err := goCDClient.CheckPermission(ctx, entityType, name)
+		if err != nil {
+			return fmt.Errorf("checking permissions on %s '%s' errored with: %w", entityType, name, err)
+		}
+
+		switch {
+		case !reported && !canAdminister:
+			return fmt.Errorf("the user configured on the provider cannot administer %s '%s', only system admins can, applying the changes would fail; "+
+				"grant the user system admin permission (or unset `check_permissions` on the provider to skip this check)", entityType, name)
+		case !canView:
+			return fmt.Errorf("the user configured on the provider cannot view %s '%s', either it does not exist and only system admins can create it, "+
+				"or the user has no permission on it, applying the changes would fail; grant the user admin permission on it "+
+				"(or unset `check_permissions` on the provider to skip this check)", entityType, name)
+		case !canAdminister:
+			return fmt.Errorf("the user configured on the provider cannot administer %s '%s', applying the changes would fail; "+
+				"grant the user admin permission on it (or unset `check_permissions` on the provider to skip this check)", entityType, name)
+		}
+
+		return nil
+	}
+}
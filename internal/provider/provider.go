@@ -2,6 +2,7 @@ package provider
 
 import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 )
 
@@ -27,10 +28,10 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"base_url": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				ForceNew:    true,
 				Computed:    false,
-				DefaultFunc: schema.EnvDefaultFunc("GOCD_BASE_URL", "www.gocd.com"),
+				DefaultFunc: schema.EnvDefaultFunc("GOCD_BASE_URL", nil),
 				Description: "base url of GoCD server, with which this terraform provider will connect with (https://gocd.myself.com/go)",
 			},
 			"ca_file": {
@@ -43,7 +44,7 @@ func Provider() *schema.Provider {
 			},
 			"username": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				ForceNew:    true,
 				Computed:    false,
 				DefaultFunc: schema.EnvDefaultFunc("GOCD_USERNAME", nil),
@@ -73,7 +74,7 @@ func Provider() *schema.Provider {
 				ForceNew:    true,
 				Computed:    false,
 				DefaultFunc: schema.EnvDefaultFunc("GOCD_LOGLEVEL", "info"),
-				Description: "loglevel to be set for the api calls made to GoCD",
+				Description: "loglevel to be set for the api calls made to GoCD, request and response of the calls are logged at `trace` under the `gocd_api` log subsystem",
 			},
 			"skip_check": {
 				Type:        schema.TypeBool,
@@ -85,40 +86,173 @@ func Provider() *schema.Provider {
 					"errors being thrown from all resource/data block defined",
 			},
 			"retries": retrySchemas(),
+			"on_conflict": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         false,
+				DefaultFunc:      schema.EnvDefaultFunc("GOCD_ON_CONFLICT", client.OnConflictRetry),
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{client.OnConflictRetry, client.OnConflictFail}, false)),
+				Description: "decides how the updates rejected by GoCD for being made on an outdated etag are handled, `retry` updates again with the latest " +
+					"etag when the changes made outside of terraform do not overlap with the ones being applied, `fail` fails with the diff of the changes. " +
+					"Defaults to `retry`.",
+			},
+			"check_permissions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    false,
+				DefaultFunc: schema.EnvDefaultFunc("GOCD_CHECK_PERMISSIONS", false),
+				Description: "setting this to true makes the resources check during plan whether the user configured on the provider can administer the " +
+					"environments, pipeline groups, config repositories, cluster profiles and elastic agent profiles they manage, " +
+					"and whether the user is a system admin for the roles, secret configs, artifact stores, auth configs and plugin settings, " +
+					"so that plan fails with a precise diagnostic instead of apply failing half-way. Defaults to `false`.",
+			},
+			"config_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    false,
+				DefaultFunc: schema.EnvDefaultFunc("GOCD_CONFIG_PATH", nil),
+				Description: "path to the auth config of gocd cli, from which the server details are loaded when not passed as arguments " +
+					"or environment variables. Defaults to `~/.gocd/auth_config.yaml`.",
+			},
+			"profile": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    false,
+				DefaultFunc: schema.EnvDefaultFunc("GOCD_PROFILE", nil),
+				Description: "name of the profile in the auth config of gocd cli to be used, top level server details are used when not set.",
+			},
+			"ca_file_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      false,
+				DefaultFunc:   schema.EnvDefaultFunc("GOCD_CA_FILE_PATH", nil),
+				ConflictsWith: []string{"ca_file"},
+				Description:   "path to the CA file, to be used while connecting to GoCD server when CA based auth is enabled, cannot co-exist with `ca_file`.",
+			},
+			"insecure_skip_verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    false,
+				DefaultFunc: schema.EnvDefaultFunc("GOCD_INSECURE_SKIP_VERIFY", false),
+				Description: "setting this to true will skip the verification of the certificate presented by GoCD server, use it only in sandboxes.",
+			},
+			"client_cert": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     false,
+				DefaultFunc:  schema.EnvDefaultFunc("GOCD_CLIENT_CERT", nil),
+				RequiredWith: []string{"client_key"},
+				Description:  "PEM encoded client certificate or the path to it, to be used for mutual TLS with GoCD server.",
+			},
+			"client_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     false,
+				Sensitive:    true,
+				DefaultFunc:  schema.EnvDefaultFunc("GOCD_CLIENT_KEY", nil),
+				RequiredWith: []string{"client_cert"},
+				Description:  "PEM encoded private key of the client certificate or the path to it, to be used for mutual TLS with GoCD server.",
+			},
+			"proxy_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    false,
+				DefaultFunc: schema.EnvDefaultFunc("GOCD_PROXY_URL", nil),
+				Description: "url of the HTTP proxy through which the requests to GoCD server has to be sent (http://proxy.myself.com:3128).",
+			},
+			"request_timeout": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Computed:         false,
+				DefaultFunc:      schema.EnvDefaultFunc("GOCD_REQUEST_TIMEOUT", nil),
+				ValidateDiagFunc: validateDuration,
+				Description:      "timeout for each of the requests made to GoCD server, as a duration (ex: `30s`, `2m`).",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    false,
+				Description: "additional headers to be set on every request made to GoCD server, ex: the ones required by a reverse proxy in front of GoCD.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"gocd_plugin_setting":        resourcePluginsSetting(),
-			"gocd_auth_config":           resourceAuthConfig(),
-			"gocd_cluster_profile":       resourceClusterProfile(),
-			"gocd_elastic_agent_profile": resourceElasticAgentProfile(),
-			"gocd_config_repository":     resourceConfigRepository(),
-			"gocd_environment":           resourceEnvironment(),
-			"gocd_encrypt_value":         resourceEncryptValue(),
-			"gocd_secret_config":         resourceSecretConfig(),
-			"gocd_backup_config":         resourceBackupConfig(),
-			"gocd_backup_schedule":       resourceBackupSchedule(),
-			"gocd_agent":                 resourceAgentConfig(),
-			"gocd_pipeline":              resourcePipeline(),
-			"gocd_artifact_store":        resourceArtifactStore(),
-			"gocd_role":                  resourceRole(),
-			"gocd_pipeline_group":        resourcePipelineGroup(),
+			"gocd_plugin_setting":            resourcePluginsSetting(),
+			"gocd_auth_config":               resourceAuthConfig(),
+			"gocd_cluster_profile":           resourceClusterProfile(),
+			"gocd_elastic_agent_profile":     resourceElasticAgentProfile(),
+			"gocd_config_repository":         resourceConfigRepository(),
+			"gocd_environment":               resourceEnvironment(),
+			"gocd_encrypt_value":             resourceEncryptValue(),
+			"gocd_secret_config":             resourceSecretConfig(),
+			"gocd_backup_config":             resourceBackupConfig(),
+			"gocd_backup_schedule":           resourceBackupSchedule(),
+			"gocd_agent":                     resourceAgentConfig(),
+			"gocd_pipeline":                  resourcePipeline(),
+			"gocd_artifact_store":            resourceArtifactStore(),
+			"gocd_role":                      resourceRole(),
+			"gocd_pipeline_group":            resourcePipelineGroup(),
+			"gocd_environment_pipeline":      resourceEnvironmentPipeline(),
+			"gocd_environment_agent":         resourceEnvironmentAgent(),
+			"gocd_environment_variable":      resourceEnvironmentVariable(),
+			"gocd_role_membership":           resourceRoleMembership(),
+			"gocd_role_binding":              resourceRoleBinding(),
+			"gocd_pipeline_group_permission": resourcePipelineGroupPermission(),
+			"gocd_maintenance_mode":          resourceMaintenanceMode(),
+			"gocd_server_ready":              resourceServerReady(),
+			"gocd_material_update_trigger":   resourceMaterialUpdateTrigger(),
+			"gocd_notification_filter":       resourceNotificationFilter(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"gocd_plugin_setting":        dataSourcePluginsSetting(),
-			"gocd_auth_config":           dataSourceAuthConfig(),
-			"gocd_cluster_profile":       dataSourceClusterProfile(),
-			"gocd_elastic_agent_profile": dataSourceElasticAgentProfile(),
-			"gocd_config_repository":     dataSourceConfigRepository(),
-			"gocd_environment":           dataSourceEnvironment(),
-			"gocd_secret_config":         dataSourceSecretConfig(),
-			"gocd_plugin_info":           dataSourcePluginInfo(),
-			"gocd_agent":                 dataSourceAgentConfig(),
-			"gocd_pipeline":              dataSourcePipeline(),
-			"gocd_artifact_store":        dataSourceArtifactStore(),
-			"gocd_role":                  dataSourceRole(),
-			"gocd_pipeline_group":        dataSourcePipelineGroup(),
+			"gocd_plugin_setting":         dataSourcePluginsSetting(),
+			"gocd_auth_config":            dataSourceAuthConfig(),
+			"gocd_cluster_profile":        dataSourceClusterProfile(),
+			"gocd_elastic_agent_profile":  dataSourceElasticAgentProfile(),
+			"gocd_config_repository":      dataSourceConfigRepository(),
+			"gocd_environment":            dataSourceEnvironment(),
+			"gocd_secret_config":          dataSourceSecretConfig(),
+			"gocd_plugin_info":            dataSourcePluginInfo(),
+			"gocd_agent":                  dataSourceAgentConfig(),
+			"gocd_pipeline":               dataSourcePipeline(),
+			"gocd_artifact_store":         dataSourceArtifactStore(),
+			"gocd_role":                   dataSourceRole(),
+			"gocd_pipeline_group":         dataSourcePipelineGroup(),
+			"gocd_config_repo_preflight":  dataSourceConfigRepoPreflight(),
+			"gocd_server_info":            dataSourceServerInfo(),
+			"gocd_server_ready":           dataSourceServerReady(),
+			"gocd_pipelines":              dataSourcePipelines(),
+			"gocd_pipeline_groups":        dataSourcePipelineGroups(),
+			"gocd_environments":           dataSourceEnvironments(),
+			"gocd_roles":                  dataSourceRoles(),
+			"gocd_cluster_profiles":       dataSourceClusterProfiles(),
+			"gocd_elastic_agent_profiles": dataSourceElasticAgentProfiles(),
+			"gocd_secret_configs":         dataSourceSecretConfigs(),
+			"gocd_config_repositories":    dataSourceConfigRepositories(),
+			"gocd_artifact_stores":        dataSourceArtifactStores(),
+			"gocd_auth_configs":           dataSourceAuthConfigs(),
+			"gocd_plugins":                dataSourcePlugins(),
+			"gocd_pipeline_history":       dataSourcePipelineHistory(),
+			"gocd_pipeline_instance":      dataSourcePipelineInstance(),
+			"gocd_pipeline_status":        dataSourcePipelineStatus(),
+			"gocd_job_artifact":           dataSourceJobArtifact(),
+			"gocd_value_stream_map":       dataSourceValueStreamMap(),
+			"gocd_pipeline_dependencies":  dataSourcePipelineDependencies(),
+			"gocd_materials":              dataSourceMaterials(),
+			"gocd_permissions":            dataSourcePermissions(),
+			"gocd_agent_job_history":      dataSourceAgentJobHistory(),
 		},
 
 		ConfigureContextFunc: client.GetGoCDClient,
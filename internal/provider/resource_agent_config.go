@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -14,6 +15,7 @@ func resourceAgentConfig() *schema.Resource {
 		CreateContext: resourceAgentConfigCreate,
 		ReadContext:   resourceAgentConfigRead,
 		DeleteContext: resourceAgentConfigDelete,
+		Timeouts:      resourceTimeouts(false),
 		Schema: map[string]*schema.Schema{
 			"uuid": {
 				Type:        schema.TypeString,
@@ -71,7 +73,7 @@ func resourceAgentConfig() *schema.Resource {
 }
 
 func resourceAgentConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
@@ -101,8 +103,8 @@ func resourceAgentConfigCreate(ctx context.Context, d *schema.ResourceData, meta
 	return resourceAgentConfigRead(ctx, d, meta)
 }
 
-func resourceAgentConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceAgentConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	response, err := defaultConfig.GetAgent(d.Id())
 	if err != nil {
@@ -3,11 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -17,6 +18,8 @@ func resourceArtifactStore() *schema.Resource {
 		ReadContext:   resourceArtifactStoreRead,
 		DeleteContext: resourceArtifactStoreDelete,
 		UpdateContext: resourceArtifactStoreUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionArtifactStore, utils.TerraformResourceStoreID, true),
 		Schema: map[string]*schema.Schema{
 			"store_id": {
 				Type:        schema.TypeString,
@@ -48,12 +51,16 @@ func resourceArtifactStore() *schema.Resource {
 }
 
 func resourceArtifactStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
 	}
 
+	if diags := checkFeature(ctx, meta, client.FeatureArtifactStores); diags.HasError() {
+		return diags
+	}
+
 	id := d.Id()
 
 	if len(id) == 0 {
@@ -76,8 +83,8 @@ func resourceArtifactStoreCreate(ctx context.Context, d *schema.ResourceData, me
 	return resourceArtifactStoreRead(ctx, d, meta)
 }
 
-func resourceArtifactStoreRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceArtifactStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	storeID := utils.String(d.Get(utils.TerraformResourceStoreID))
 	response, err := defaultConfig.GetArtifactStore(storeID)
@@ -93,10 +100,10 @@ func resourceArtifactStoreRead(_ context.Context, d *schema.ResourceData, meta i
 }
 
 func resourceArtifactStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.HasChange(utils.TerraformResourceProperties) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -105,10 +112,23 @@ func resourceArtifactStoreUpdate(ctx context.Context, d *schema.ResourceData, me
 		ID:         utils.String(d.Get(utils.TerraformResourceStoreID)),
 		PluginID:   utils.String(d.Get(utils.TerraformResourcePluginID)),
 		Properties: getPluginConfiguration(d.Get(utils.TerraformResourceProperties)),
-		ETAG:       utils.String(d.Get(utils.TerraformResourceEtag)),
 	}
 
-	_, err := defaultConfig.UpdateArtifactStore(cfg)
+	baseCfg := cfg
+	baseCfg.Properties = getPluginConfiguration(getOldValue(d)(utils.TerraformResourceProperties))
+
+	err := updateOnConflict(ctx, meta, "artifact store", cfg.ID, baseCfg, cfg, utils.String(d.Get(utils.TerraformResourceEtag)),
+		func() (gocd.CommonConfig, string, error) {
+			response, err := defaultConfig.GetArtifactStore(cfg.ID)
+
+			return response, response.ETAG, err
+		},
+		func(store gocd.CommonConfig, etag string) error {
+			store.ETAG = etag
+			_, err := defaultConfig.UpdateArtifactStore(store)
+
+			return err
+		})
 	if err != nil {
 		return diag.Errorf("updating artifact store config '%s' errored with: %v", cfg.ID, err)
 	}
@@ -116,8 +136,8 @@ func resourceArtifactStoreUpdate(ctx context.Context, d *schema.ResourceData, me
 	return resourceArtifactStoreRead(ctx, d, meta)
 }
 
-func resourceArtifactStoreDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceArtifactStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	id := d.Id()
 	if len(d.Id()) == 0 {
@@ -136,8 +156,8 @@ func resourceArtifactStoreDelete(_ context.Context, d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceArtifactStoreImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceArtifactStoreImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	storeID := utils.String(d.Id())
 	response, err := defaultConfig.GetArtifactStore(storeID)
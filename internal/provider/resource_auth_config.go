@@ -18,11 +18,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -32,6 +33,8 @@ func resourceAuthConfig() *schema.Resource {
 		ReadContext:   resourceAuthConfigRead,
 		DeleteContext: resourceAuthConfigDelete,
 		UpdateContext: resourceAuthConfigUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionAuthConfig, utils.TerraformResourceProfileID, true),
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
 				Type:        schema.TypeString,
@@ -70,7 +73,7 @@ func resourceAuthConfig() *schema.Resource {
 }
 
 func resourceAuthConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
@@ -100,8 +103,8 @@ func resourceAuthConfigCreate(ctx context.Context, d *schema.ResourceData, meta
 	return resourceAuthConfigRead(ctx, d, meta)
 }
 
-func resourceAuthConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceAuthConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(d.Get(utils.TerraformResourceProfileID))
 	response, err := defaultConfig.GetAuthConfig(profileID)
@@ -117,10 +120,10 @@ func resourceAuthConfigRead(_ context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceAuthConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.HasChange(utils.TerraformResourceProperties) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -130,10 +133,23 @@ func resourceAuthConfigUpdate(ctx context.Context, d *schema.ResourceData, meta
 		PluginID:            utils.String(d.Get(utils.TerraformResourcePluginID)),
 		Properties:          getPluginConfiguration(d.Get(utils.TerraformResourceProperties)),
 		AllowOnlyKnownUsers: utils.Bool(d.Get(utils.TerraformResourceAllowKnownUser)),
-		ETAG:                utils.String(d.Get(utils.TerraformResourceEtag)),
 	}
 
-	_, err := defaultConfig.UpdateAuthConfig(cfg)
+	baseCfg := cfg
+	baseCfg.Properties = getPluginConfiguration(getOldValue(d)(utils.TerraformResourceProperties))
+
+	err := updateOnConflict(ctx, meta, "auth configuration", cfg.ID, baseCfg, cfg, utils.String(d.Get(utils.TerraformResourceEtag)),
+		func() (gocd.CommonConfig, string, error) {
+			response, err := defaultConfig.GetAuthConfig(cfg.ID)
+
+			return response, response.ETAG, err
+		},
+		func(authConfig gocd.CommonConfig, etag string) error {
+			authConfig.ETAG = etag
+			_, err := defaultConfig.UpdateAuthConfig(authConfig)
+
+			return err
+		})
 	if err != nil {
 		return diag.Errorf("updating auth configuration %s errored with: %v", cfg.ID, err)
 	}
@@ -141,8 +157,8 @@ func resourceAuthConfigUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return resourceAuthConfigRead(ctx, d, meta)
 }
 
-func resourceAuthConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceAuthConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	id := d.Id()
 	if len(d.Id()) == 0 {
@@ -161,8 +177,8 @@ func resourceAuthConfigDelete(_ context.Context, d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAuthConfigImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceAuthConfigImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(d.Id())
 	response, err := defaultConfig.GetAuthConfig(profileID)
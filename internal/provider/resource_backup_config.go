@@ -2,11 +2,12 @@ package provider
 
 import (
 	"context"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -16,6 +17,7 @@ func resourceBackupConfig() *schema.Resource {
 		ReadContext:   resourceBackupConfigRead,
 		UpdateContext: resourceBackupConfigUpdate,
 		DeleteContext: resourceBackupConfigDelete,
+		Timeouts:      resourceTimeouts(true),
 		Schema: map[string]*schema.Schema{
 			"schedule": {
 				Type:        schema.TypeString,
@@ -46,12 +48,16 @@ func resourceBackupConfig() *schema.Resource {
 }
 
 func resourceBackupConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
 	}
 
+	if diags := checkFeature(ctx, meta, client.FeatureBackupConfig); diags.HasError() {
+		return diags
+	}
+
 	id := d.Id()
 
 	if len(id) == 0 {
@@ -75,8 +81,8 @@ func resourceBackupConfigCreate(ctx context.Context, d *schema.ResourceData, met
 	return resourceBackupConfigRead(ctx, d, meta)
 }
 
-func resourceBackupConfigRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceBackupConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	response, err := defaultConfig.GetBackupConfig()
 	if err != nil {
@@ -103,7 +109,7 @@ func resourceBackupConfigRead(_ context.Context, d *schema.ResourceData, meta in
 }
 
 func resourceBackupConfigUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.HasChanges(
 		utils.TerraformResourceSchedule,
@@ -111,7 +117,7 @@ func resourceBackupConfigUpdate(ctx context.Context, d *schema.ResourceData, met
 		utils.TerraformResourceEmailOnSuccess,
 		utils.TerraformResourceEmailOnFailure,
 	) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -130,8 +136,8 @@ func resourceBackupConfigUpdate(ctx context.Context, d *schema.ResourceData, met
 	return resourceBackupConfigRead(ctx, d, meta)
 }
 
-func resourceBackupConfigDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceBackupConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	id := d.Id()
 	if len(d.Id()) == 0 {
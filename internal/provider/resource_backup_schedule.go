@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"log"
 	"strconv"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -17,6 +17,7 @@ func resourceBackupSchedule() *schema.Resource {
 		CreateContext: resourceBackupScheduleCreate,
 		ReadContext:   resourceBackupScheduleRead,
 		DeleteContext: resourceBackupScheduleDelete,
+		Timeouts:      resourceTimeouts(false),
 		Schema: map[string]*schema.Schema{
 			"schedule": {
 				Type:        schema.TypeBool,
@@ -63,7 +64,7 @@ var (
 )
 
 func resourceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
@@ -106,8 +107,8 @@ func resourceBackupScheduleCreate(ctx context.Context, d *schema.ResourceData, m
 	return resourceBackupScheduleRead(ctx, d, meta)
 }
 
-func resourceBackupScheduleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceBackupScheduleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	retryAfter := d.Get(utils.TerraformResourceRetryAfter).(int)
 	backupRetry := d.Get(utils.TerraformResourceRetry).(int)
@@ -132,7 +133,7 @@ func resourceBackupScheduleRead(_ context.Context, d *schema.ResourceData, meta
 
 		retryRemaining := backupRetry - currentRetryCount
 		if response.Status == "IN_PROGRESS" {
-			log.Printf("the backup stats is still in IN_PROGRESS status, retrying... '%d' more to go", retryRemaining)
+			tflog.Debug(ctx, "the backup stats is still in IN_PROGRESS status, retrying", map[string]interface{}{"retries_remaining": retryRemaining})
 		}
 
 		if response.Status == "COMPLETED" {
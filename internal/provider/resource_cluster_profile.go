@@ -18,11 +18,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -32,6 +33,8 @@ func resourceClusterProfile() *schema.Resource {
 		ReadContext:   resourceClusterProfileRead,
 		DeleteContext: resourceClusterProfileDelete,
 		UpdateContext: resourceClusterProfileUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionClusterProfile, utils.TerraformResourceProfileID, true),
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
 				Type:        schema.TypeString,
@@ -63,12 +66,16 @@ func resourceClusterProfile() *schema.Resource {
 }
 
 func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
 	}
 
+	if diags := checkFeature(ctx, meta, client.FeatureClusterProfiles); diags.HasError() {
+		return diags
+	}
+
 	id := d.Id()
 
 	if len(id) == 0 {
@@ -92,8 +99,8 @@ func resourceClusterProfileCreate(ctx context.Context, d *schema.ResourceData, m
 	return resourceClusterProfileRead(ctx, d, meta)
 }
 
-func resourceClusterProfileRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceClusterProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(d.Get(utils.TerraformResourceProfileID))
 	response, err := defaultConfig.GetClusterProfile(profileID)
@@ -109,10 +116,10 @@ func resourceClusterProfileRead(_ context.Context, d *schema.ResourceData, meta
 }
 
 func resourceClusterProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.HasChange(utils.TerraformResourceProperties) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -121,10 +128,23 @@ func resourceClusterProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 		ID:         utils.String(d.Get(utils.TerraformResourceProfileID)),
 		PluginID:   utils.String(d.Get(utils.TerraformResourcePluginID)),
 		Properties: getPluginConfiguration(d.Get(utils.TerraformResourceProperties)),
-		ETAG:       utils.String(d.Get(utils.TerraformResourceEtag)),
 	}
 
-	_, err := defaultConfig.UpdateClusterProfile(cfg)
+	baseCfg := cfg
+	baseCfg.Properties = getPluginConfiguration(getOldValue(d)(utils.TerraformResourceProperties))
+
+	err := updateOnConflict(ctx, meta, "cluster profile", cfg.ID, baseCfg, cfg, utils.String(d.Get(utils.TerraformResourceEtag)),
+		func() (gocd.CommonConfig, string, error) {
+			response, err := defaultConfig.GetClusterProfile(cfg.ID)
+
+			return response, response.ETAG, err
+		},
+		func(profile gocd.CommonConfig, etag string) error {
+			profile.ETAG = etag
+			_, err := defaultConfig.UpdateClusterProfile(profile)
+
+			return err
+		})
 	if err != nil {
 		return diag.Errorf("updating cluster profile %s errored with: %v", cfg.ID, err)
 	}
@@ -132,8 +152,8 @@ func resourceClusterProfileUpdate(ctx context.Context, d *schema.ResourceData, m
 	return resourceClusterProfileRead(ctx, d, meta)
 }
 
-func resourceClusterProfileDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceClusterProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	id := d.Id()
 	if len(d.Id()) == 0 {
@@ -152,8 +172,8 @@ func resourceClusterProfileDelete(_ context.Context, d *schema.ResourceData, met
 	return nil
 }
 
-func resourceClusterProfileImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceClusterProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(d.Id())
 	response, err := defaultConfig.GetClusterProfile(profileID)
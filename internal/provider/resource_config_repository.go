@@ -2,13 +2,13 @@ package provider
 
 import (
 	"context"
-	"log"
 
-	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -18,6 +18,8 @@ func resourceConfigRepository() *schema.Resource {
 		ReadContext:   resourceConfigRepoRead,
 		DeleteContext: resourceConfigRepoDelete,
 		UpdateContext: resourceConfigRepoUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionConfigRepo, utils.TerraformResourceProfileID, true),
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
 				Type:        schema.TypeString,
@@ -47,13 +49,8 @@ func resourceConfigRepository() *schema.Resource {
 				Computed:    false,
 				ForceNew:    true,
 				Description: "The list of rules, which allows restricting the entities that the config repo can refer to.",
-				Elem: &schema.Schema{
-					Type:        schema.TypeMap,
-					Required:    false,
-					Computed:    false,
-					ForceNew:    false,
-					Description: "Rule, which allows restricting the entities that the config repo can refer to.",
-				},
+				ConfigMode:  schema.SchemaConfigModeAttr,
+				Elem:        policySchema(utils.TerraformResourceDirective, ruleActions, configRepoRuleTypes),
 			},
 			"etag": {
 				Type:        schema.TypeString,
@@ -67,12 +64,18 @@ func resourceConfigRepository() *schema.Resource {
 }
 
 func resourceConfigRepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
 	}
 
+	if _, ok := d.GetOk(utils.TerraformResourceRules); ok {
+		if diags := checkFeature(ctx, meta, client.FeatureConfigRepoRules); diags.HasError() {
+			return diags
+		}
+	}
+
 	id := d.Id()
 
 	if len(id) == 0 {
@@ -104,8 +107,8 @@ func resourceConfigRepoCreate(ctx context.Context, d *schema.ResourceData, meta
 	return resourceConfigRepoRead(ctx, d, meta)
 }
 
-func resourceConfigRepoRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceConfigRepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(d.Get(utils.TerraformResourceProfileID))
 	response, err := defaultConfig.GetConfigRepo(profileID)
@@ -121,16 +124,16 @@ func resourceConfigRepoRead(_ context.Context, d *schema.ResourceData, meta inte
 }
 
 func resourceConfigRepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
-	if d.HasChange(utils.TerraformResourceMaterial) ||
-		d.HasChange(utils.TerraformResourceRules) {
-		oldCfg, newCfg := d.GetChange(utils.TerraformResourceProperties)
-
-		if cmp.Equal(oldCfg, newCfg) {
-			return nil
+	if _, ok := d.GetOk(utils.TerraformResourceRules); ok {
+		if diags := checkFeature(ctx, meta, client.FeatureConfigRepoRules); diags.HasError() {
+			return diags
 		}
+	}
 
+	if d.HasChange(utils.TerraformResourceMaterial) ||
+		d.HasChange(utils.TerraformResourceRules) {
 		rules, err := flattenMapSlice(d.Get(utils.TerraformResourceRules))
 		if err != nil {
 			return diag.Errorf("reading rules errored with %v", err)
@@ -143,10 +146,29 @@ func resourceConfigRepoUpdate(ctx context.Context, d *schema.ResourceData, meta
 			PluginID: utils.String(d.Get(utils.TerraformResourcePluginID)),
 			Rules:    rules,
 			Material: material,
-			ETAG:     utils.String(d.Get(utils.TerraformResourceEtag)),
 		}
 
-		_, err = defaultConfig.UpdateConfigRepo(cfg)
+		baseRules, err := flattenMapSlice(getOldValue(d)(utils.TerraformResourceRules))
+		if err != nil {
+			return diag.Errorf("reading rules errored with %v", err)
+		}
+
+		baseCfg := cfg
+		baseCfg.Rules = baseRules
+		baseCfg.Material = getMaterials(getOldValue(d)(utils.TerraformResourceMaterial))
+
+		err = updateOnConflict(ctx, meta, "config repo", cfg.ID, baseCfg, cfg, utils.String(d.Get(utils.TerraformResourceEtag)),
+			func() (gocd.ConfigRepo, string, error) {
+				response, err := defaultConfig.GetConfigRepo(cfg.ID)
+
+				return response, response.ETAG, err
+			},
+			func(repo gocd.ConfigRepo, etag string) error {
+				repo.ETAG = etag
+				_, err := defaultConfig.UpdateConfigRepo(repo)
+
+				return err
+			})
 		if err != nil {
 			return diag.Errorf("updating config repo %s errored with: %v", cfg.ID, err)
 		}
@@ -154,13 +176,13 @@ func resourceConfigRepoUpdate(ctx context.Context, d *schema.ResourceData, meta
 		return resourceConfigRepoRead(ctx, d, meta)
 	}
 
-	log.Printf("nothing to update so skipping")
+	tflog.Debug(ctx, "nothing to update so skipping")
 
 	return nil
 }
 
-func resourceConfigRepoDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceConfigRepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if id := d.Id(); len(id) == 0 {
 		return diag.Errorf("resource with the ID '%s' not found", id)
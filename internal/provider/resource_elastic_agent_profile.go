@@ -3,11 +3,12 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -17,6 +18,8 @@ func resourceElasticAgentProfile() *schema.Resource {
 		ReadContext:   resourceElasticAgentProfileRead,
 		DeleteContext: resourceElasticAgentProfileDelete,
 		UpdateContext: resourceElasticAgentProfileUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionElasticAgentProfile, utils.TerraformResourceProfileID, true),
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
 				Type:        schema.TypeString,
@@ -48,12 +51,16 @@ func resourceElasticAgentProfile() *schema.Resource {
 }
 
 func resourceElasticAgentProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
 	}
 
+	if diags := checkFeature(ctx, meta, client.FeatureClusterProfiles); diags.HasError() {
+		return diags
+	}
+
 	id := d.Id()
 
 	if len(id) == 0 {
@@ -77,8 +84,8 @@ func resourceElasticAgentProfileCreate(ctx context.Context, d *schema.ResourceDa
 	return resourceElasticAgentProfileRead(ctx, d, meta)
 }
 
-func resourceElasticAgentProfileRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceElasticAgentProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(d.Get(utils.TerraformResourceProfileID))
 	response, err := defaultConfig.GetElasticAgentProfile(profileID)
@@ -94,10 +101,10 @@ func resourceElasticAgentProfileRead(_ context.Context, d *schema.ResourceData,
 }
 
 func resourceElasticAgentProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.HasChange(utils.TerraformResourceProperties) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -106,10 +113,23 @@ func resourceElasticAgentProfileUpdate(ctx context.Context, d *schema.ResourceDa
 		ID:               utils.String(d.Get(utils.TerraformResourceProfileID)),
 		ClusterProfileID: utils.String(d.Get(utils.TerraformResourceClusterProfileID)),
 		Properties:       getPluginConfiguration(d.Get(utils.TerraformResourceProperties)),
-		ETAG:             utils.String(d.Get(utils.TerraformResourceEtag)),
 	}
 
-	_, err := defaultConfig.UpdateElasticAgentProfile(cfg)
+	baseCfg := cfg
+	baseCfg.Properties = getPluginConfiguration(getOldValue(d)(utils.TerraformResourceProperties))
+
+	err := updateOnConflict(ctx, meta, "elastic agent profile", cfg.ID, baseCfg, cfg, utils.String(d.Get(utils.TerraformResourceEtag)),
+		func() (gocd.CommonConfig, string, error) {
+			response, err := defaultConfig.GetElasticAgentProfile(cfg.ID)
+
+			return response, response.ETAG, err
+		},
+		func(profile gocd.CommonConfig, etag string) error {
+			profile.ETAG = etag
+			_, err := defaultConfig.UpdateElasticAgentProfile(profile)
+
+			return err
+		})
 	if err != nil {
 		return diag.Errorf("updating elastic agent profile %s errored with: %v", cfg.ID, err)
 	}
@@ -117,8 +137,8 @@ func resourceElasticAgentProfileUpdate(ctx context.Context, d *schema.ResourceDa
 	return resourceElasticAgentProfileRead(ctx, d, meta)
 }
 
-func resourceElasticAgentProfileDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceElasticAgentProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if id := d.Id(); len(id) == 0 {
 		return diag.Errorf("resource with the ID '%s' not found", id)
@@ -136,8 +156,8 @@ func resourceElasticAgentProfileDelete(_ context.Context, d *schema.ResourceData
 	return nil
 }
 
-func resourceElasticAgentProfileImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceElasticAgentProfileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(d.Id())
 	response, err := defaultConfig.GetElasticAgentProfile(profileID)
@@ -5,7 +5,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
-	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -14,6 +14,7 @@ func resourceEncryptValue() *schema.Resource {
 		CreateContext: resourceEncryptValueCreate,
 		ReadContext:   resourceEncryptValueRead,
 		DeleteContext: resourceEncryptValueDelete,
+		Timeouts:      resourceTimeouts(false),
 		Schema: map[string]*schema.Schema{
 			"value": {
 				Type:        schema.TypeString,
@@ -34,8 +35,8 @@ func resourceEncryptValue() *schema.Resource {
 	}
 }
 
-func resourceEncryptValueCreate(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceEncryptValueCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
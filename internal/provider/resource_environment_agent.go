@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceEnvironmentAgent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentAgentCreate,
+		ReadContext:   resourceEnvironmentAgentRead,
+		DeleteContext: resourceEnvironmentAgentDelete,
+		Timeouts:      resourceTimeouts(false),
+		CustomizeDiff: checkPermission(client.PermissionEnvironment, utils.TerraformResourceEnvironment, false),
+		Schema: map[string]*schema.Schema{
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the environment to which the agent should be added.",
+			},
+			"agent_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The identifier (uuid) of the agent that should be added to the environment.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentAgentImport,
+		},
+	}
+}
+
+func resourceEnvironmentAgentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	agentID := utils.String(d.Get(utils.TerraformResourceAgentID))
+
+	err := patchAgentEnvironments(ctx, defaultConfig, agentID, envName, true)
+	if err != nil {
+		return diag.Errorf("adding agent '%s' to environment '%s' errored with: %v", agentID, envName, err)
+	}
+
+	d.SetId(utils.GetMembershipID(envName, agentID))
+
+	return resourceEnvironmentAgentRead(ctx, d, meta)
+}
+
+func resourceEnvironmentAgentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	agentID := utils.String(d.Get(utils.TerraformResourceAgentID))
+
+	response, err := defaultConfig.GetAgent(agentID)
+	if err != nil {
+		return diag.Errorf("fetching information of agent '%s' errored with %v", agentID, err)
+	}
+
+	if !utils.Contains(flattenEnvironments(response.Environments), envName) {
+		tflog.Warn(ctx, "agent is no longer part of the environment, removing it from state", map[string]interface{}{"agent_id": agentID, "environment": envName})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceEnvironmentAgentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	agentID := utils.String(d.Get(utils.TerraformResourceAgentID))
+
+	err := patchAgentEnvironments(ctx, defaultConfig, agentID, envName, false)
+	if err != nil {
+		return diag.Errorf("removing agent '%s' from environment '%s' errored with: %v", agentID, envName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceEnvironmentAgentImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	envName, agentID, err := utils.ParseMembershipID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(utils.TerraformResourceEnvironment, envName); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceEnvironment, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceAgentID, agentID); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceAgentID, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// patchAgentEnvironments adds or removes the agent from the environment with the bulk update of the agents, GoCD applies it on the
+// latest environments of the agent so that the changes made to them in between are not overwritten. Skipped when it is already done.
+func patchAgentEnvironments(ctx context.Context, defaultConfig gocd.GoCd, agentID, envName string, add bool) error {
+	agent, err := defaultConfig.GetAgent(agentID)
+	if err != nil {
+		return fmt.Errorf("fetching information of agent '%s' errored with %w", agentID, err)
+	}
+
+	if utils.Contains(flattenEnvironments(agent.Environments), envName) == add {
+		tflog.Debug(ctx, "environments of the agent are already up to date so skipping", map[string]interface{}{"agent_id": agentID})
+
+		return nil
+	}
+
+	environments := gocd.AddRemoves{Remove: []string{envName}}
+	if add {
+		environments = gocd.AddRemoves{Add: []string{envName}}
+	}
+
+	if err = defaultConfig.UpdateAgentBulk(gocd.Agent{UUIDS: []string{agentID}, Operations: gocd.Operations{Environments: environments}}); err != nil {
+		return fmt.Errorf("updating agent '%s' errored with %w", agentID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceEnvironmentPipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentPipelineCreate,
+		ReadContext:   resourceEnvironmentPipelineRead,
+		DeleteContext: resourceEnvironmentPipelineDelete,
+		Timeouts:      resourceTimeouts(false),
+		CustomizeDiff: checkPermission(client.PermissionEnvironment, utils.TerraformResourceEnvironment, false),
+		Schema: map[string]*schema.Schema{
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the environment to which the pipeline should be added.",
+			},
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the pipeline that should be added to the environment.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentPipelineImport,
+		},
+	}
+}
+
+func resourceEnvironmentPipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	err := patchEnvironmentPipelines(ctx, meta, envName, pipeline, true)
+	if err != nil {
+		return diag.Errorf("adding pipeline '%s' to environment '%s' errored with: %v", pipeline, envName, err)
+	}
+
+	d.SetId(utils.GetMembershipID(envName, pipeline))
+
+	return resourceEnvironmentPipelineRead(ctx, d, meta)
+}
+
+func resourceEnvironmentPipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	response, err := defaultConfig.GetEnvironment(envName)
+	if err != nil {
+		return diag.Errorf("getting environment %s errored with: %v", envName, err)
+	}
+
+	if !utils.Contains(getPipelineNames(response.Pipelines), pipeline) {
+		tflog.Warn(ctx, "pipeline is no longer part of the environment, removing it from state", map[string]interface{}{"pipeline": pipeline, "environment": envName})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceEnvironmentPipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	pipeline := utils.String(d.Get(utils.TerraformResourcePipeline))
+
+	err := patchEnvironmentPipelines(ctx, meta, envName, pipeline, false)
+	if err != nil {
+		return diag.Errorf("removing pipeline '%s' from environment '%s' errored with: %v", pipeline, envName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceEnvironmentPipelineImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	envName, pipeline, err := utils.ParseMembershipID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(utils.TerraformResourceEnvironment, envName); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceEnvironment, err)
+	}
+
+	if err = d.Set(utils.TerraformResourcePipeline, pipeline); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourcePipeline, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getPipelineNames(pipelines []gocd.Pipeline) []string {
+	names := make([]string, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		names = append(names, pipeline.Name)
+	}
+
+	return names
+}
+
+// patchEnvironmentPipelines adds or removes the pipeline from the environment with a PATCH call that GoCD applies on the latest config
+// of the environment, so that the changes made to the environment in between are not overwritten. Skipped when it is already done.
+func patchEnvironmentPipelines(ctx context.Context, meta interface{}, envName, pipeline string, add bool) error {
+	environment, err := meta.(client.GoCD).WithContext(ctx).GetEnvironment(envName)
+	if err != nil {
+		return fmt.Errorf("getting environment %s errored with: %w", envName, err)
+	}
+
+	if utils.Contains(getPipelineNames(environment.Pipelines), pipeline) == add {
+		tflog.Debug(ctx, "environment is already up to date so skipping", map[string]interface{}{"environment": envName, "pipeline": pipeline})
+
+		return nil
+	}
+
+	pipelines := client.AddRemove{Remove: []string{pipeline}}
+	if add {
+		pipelines = client.AddRemove{Add: []string{pipeline}}
+	}
+
+	return meta.(client.GoCD).PatchEnvironmentPipelines(ctx, envName, pipelines)
+}
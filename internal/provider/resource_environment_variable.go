@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceEnvironmentVariable() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceEnvironmentVariableCreate,
+		ReadContext:   resourceEnvironmentVariableRead,
+		UpdateContext: resourceEnvironmentVariableUpdate,
+		DeleteContext: resourceEnvironmentVariableDelete,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionEnvironment, utils.TerraformResourceEnvironment, false),
+		Schema: map[string]*schema.Schema{
+			"environment": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the environment to which the environment variable should be added.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the environment variable.",
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         false,
+				Sensitive:        true,
+				ExactlyOneOf:     []string{utils.TerraformResourceValue, utils.TerraformResourceENCValue},
+				DiffSuppressFunc: suppressSecureValueDiff,
+				Description: "The value of the environment variable. You MUST specify one of value or encrypted_value. " +
+					"Value of the secure variable is sent to GoCD only while writing, only its checksum is stored in the state.",
+			},
+			"encrypted_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{utils.TerraformResourceValue, utils.TerraformResourceENCValue},
+				Description:  "The encrypted value of the environment variable. You MUST specify one of value or encrypted_value.",
+			},
+			"secure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Description: "Whether environment variable is secure or not. When set to true, encrypts the value if one is specified. The default value is false.",
+			},
+			"value_checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Checksum of the plain text value of the secure environment variable, used to detect the changes made to value.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceEnvironmentVariableImport,
+		},
+	}
+}
+
+func resourceEnvironmentVariableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	envVar := getEnvironmentVariable(d)
+
+	if err := patchEnvironmentVariable(ctx, meta, envName, envVar.Name, &envVar); err != nil {
+		return diag.Errorf("adding environment variable '%s' to environment '%s' errored with: %v", envVar.Name, envName, err)
+	}
+
+	d.SetId(utils.GetMembershipID(envName, envVar.Name))
+
+	return resourceEnvironmentVariableRead(ctx, d, meta)
+}
+
+func resourceEnvironmentVariableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	name := utils.String(d.Get(utils.TerraformResourceName))
+
+	response, err := defaultConfig.GetEnvironment(envName)
+	if err != nil {
+		return diag.Errorf("getting environment %s errored with: %v", envName, err)
+	}
+
+	envVar, found := findEnvironmentVariable(response.EnvVars, name)
+	if !found {
+		tflog.Warn(ctx, "environment variable is no longer part of the environment, removing it from state", map[string]interface{}{"name": name, "environment": envName})
+		d.SetId("")
+
+		return nil
+	}
+
+	if err = d.Set(utils.TerraformResourceSecure, envVar.Secure); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecure, err)
+	}
+
+	current := map[string]interface{}{
+		utils.TerraformResourceName:          name,
+		utils.TerraformResourceValue:         d.Get(utils.TerraformResourceValue),
+		utils.TerraformResourceENCValue:      d.Get(utils.TerraformResourceENCValue),
+		utils.TerraformResourceValueChecksum: d.Get(utils.TerraformResourceValueChecksum),
+	}
+
+	flattenedEnvVar := map[string]interface{}{
+		utils.TerraformResourceValue:         envVar.Value,
+		utils.TerraformResourceENCValue:      "",
+		utils.TerraformResourceValueChecksum: "",
+	}
+
+	if envVar.Secure {
+		flattenedEnvVar[utils.TerraformResourceValue] = ""
+		flattenedEnvVar[utils.TerraformResourceENCValue] = envVar.EncryptedValue
+		flattenedEnvVar[utils.TerraformResourceValueChecksum] = getSecureValueChecksumFromState(ctx, current, envVar.EncryptedValue)
+	}
+
+	for key, value := range flattenedEnvVar {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceEnvironmentVariableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges(utils.TerraformResourceValue, utils.TerraformResourceENCValue, utils.TerraformResourceSecure) {
+		tflog.Debug(ctx, "nothing to update so skipping")
+
+		return nil
+	}
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	envVar := getEnvironmentVariable(d)
+
+	if err := patchEnvironmentVariable(ctx, meta, envName, envVar.Name, &envVar); err != nil {
+		return diag.Errorf("updating environment variable '%s' of environment '%s' errored with: %v", envVar.Name, envName, err)
+	}
+
+	return resourceEnvironmentVariableRead(ctx, d, meta)
+}
+
+func resourceEnvironmentVariableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	envName := utils.String(d.Get(utils.TerraformResourceEnvironment))
+	name := utils.String(d.Get(utils.TerraformResourceName))
+
+	err := patchEnvironmentVariable(ctx, meta, envName, name, nil)
+	if err != nil {
+		return diag.Errorf("removing environment variable '%s' from environment '%s' errored with: %v", name, envName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceEnvironmentVariableImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	envName, name, err := utils.ParseMembershipID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(utils.TerraformResourceEnvironment, envName); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceEnvironment, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceName, name); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceName, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getEnvironmentVariable(d *schema.ResourceData) gocd.EnvVars {
+	envVar := gocd.EnvVars{
+		Name:           utils.String(d.Get(utils.TerraformResourceName)),
+		Value:          utils.String(d.Get(utils.TerraformResourceValue)),
+		EncryptedValue: utils.String(d.Get(utils.TerraformResourceENCValue)),
+		Secure:         utils.Bool(d.Get(utils.TerraformResourceSecure)),
+	}
+
+	// plain text value of the secure variable is present only when it is being written, GoCD would encrypt it again.
+	if envVar.Secure && len(envVar.Value) != 0 {
+		envVar.EncryptedValue = ""
+	}
+
+	return envVar
+}
+
+// setEnvironmentVariable adds the environment variable to the environment or replaces the one with the same name.
+// patchEnvironmentVariable replaces the environment variable of the environment by its name with envVar in a single PATCH call,
+// the variable is only removed when envVar is nil. The call is skipped when there is no variable to be removed and none to be added.
+func patchEnvironmentVariable(ctx context.Context, meta interface{}, envName, name string, envVar *gocd.EnvVars) error {
+	environment, err := meta.(client.GoCD).WithContext(ctx).GetEnvironment(envName)
+	if err != nil {
+		return fmt.Errorf("getting environment %s errored with: %w", envName, err)
+	}
+
+	envVars := client.EnvironmentVariablesPatch{}
+	if _, found := findEnvironmentVariable(environment.EnvVars, name); found {
+		envVars.Remove = []string{name}
+	}
+
+	if envVar != nil {
+		envVars.Add = []gocd.EnvVars{*envVar}
+	}
+
+	if len(envVars.Add) == 0 && len(envVars.Remove) == 0 {
+		tflog.Debug(ctx, "environment is already up to date so skipping", map[string]interface{}{"environment": envName, "name": name})
+
+		return nil
+	}
+
+	return meta.(client.GoCD).PatchEnvironmentChanges(ctx, envName, client.EnvironmentPatch{EnvironmentVariables: &envVars})
+}
+
+func findEnvironmentVariable(envVars []gocd.EnvVars, name string) (gocd.EnvVars, bool) {
+	for _, envVar := range envVars {
+		if envVar.Name == name {
+			return envVar, true
+		}
+	}
+
+	return gocd.EnvVars{}, false
+}
@@ -3,13 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
+	"slices"
+	"strings"
 
-	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/mitchellh/mapstructure"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -19,6 +21,8 @@ func resourceEnvironment() *schema.Resource {
 		ReadContext:   resourceEnvironmentRead,
 		DeleteContext: resourceEnvironmentDelete,
 		UpdateContext: resourceEnvironmentUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionEnvironment, utils.TerraformResourceName, true),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -35,6 +39,8 @@ func resourceEnvironment() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"environment_variables": environmentsSchemaResource(),
+			"secure_variables": secureVariablesSchema("The secure environment variables of the environment, plain text value of these variables " +
+				"is sent to GoCD only while writing, their encrypted value in GoCD is tracked to detect the changes made outside of terraform."),
 			"etag": {
 				Type:        schema.TypeString,
 				Required:    false,
@@ -49,14 +55,8 @@ func resourceEnvironment() *schema.Resource {
 	}
 }
 
-type environmentChanges struct {
-	envVarsChanges  []gocd.EnvVars
-	pipelineChanges []gocd.Pipeline
-	equal           bool
-}
-
 func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
@@ -83,13 +83,17 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, meta
 		return diag.Errorf("creating environment %s errored with %v", cfg.Name, err)
 	}
 
+	if err = d.Set(utils.TerraformResourceSecureVariables, getEnvironmentSecureVariables(envVars)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureVariables, err)
+	}
+
 	d.SetId(id)
 
 	return resourceEnvironmentRead(ctx, d, meta)
 }
 
-func resourceEnvironmentRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	envName := utils.String(d.Get(utils.TerraformResourceName))
 	response, err := defaultConfig.GetEnvironment(envName)
@@ -97,6 +101,16 @@ func resourceEnvironmentRead(_ context.Context, d *schema.ResourceData, meta int
 		return diag.Errorf("getting environment %s errored with: %v", envName, err)
 	}
 
+	envVars, secureVariables := flattenEnvironmentVariables(ctx, response.EnvVars, d.Get(utils.TerraformResourceEnvVar),
+		d.Get(utils.TerraformResourceSecureVariables), false)
+	if err = d.Set(utils.TerraformResourceEnvVar, envVars); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceEnvVar, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceSecureVariables, secureVariables); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureVariables, err)
+	}
+
 	if err = d.Set(utils.TerraformResourceEtag, response.ETAG); err != nil {
 		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceEtag, err)
 	}
@@ -105,40 +119,36 @@ func resourceEnvironmentRead(_ context.Context, d *schema.ResourceData, meta int
 }
 
 func resourceEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
-
-	if d.HasChange(utils.TerraformResourcePipelines) || d.HasChange(utils.TerraformResourceEnvVar) {
-		changes, err := getEnvChanges(d)
-		if err != nil {
-			return diag.Errorf("fetching changes errored with %v", err)
-		}
+	if !d.HasChange(utils.TerraformResourcePipelines) && !d.HasChange(utils.TerraformResourceEnvVar) {
+		tflog.Debug(ctx, "nothing to update so skipping")
 
-		if changes.equal {
-			return nil
-		}
+		return nil
+	}
 
-		cfg := gocd.Environment{
-			Name:      utils.String(d.Get(utils.TerraformResourceName)),
-			Pipelines: changes.pipelineChanges,
-			EnvVars:   changes.envVarsChanges,
-			ETAG:      utils.String(d.Get(utils.TerraformResourceEtag)),
-		}
+	envName := utils.String(d.Get(utils.TerraformResourceName))
 
-		_, err = defaultConfig.UpdateEnvironment(cfg)
-		if err != nil {
-			return diag.Errorf("updating environment %s errored with: %v", cfg.Name, err)
-		}
+	patch, err := getEnvironmentPatch(d)
+	if err != nil {
+		return diag.Errorf("fetching changes errored with %v", err)
+	}
 
-		return resourceEnvironmentRead(ctx, d, meta)
+	// the changes are patched, so that the pipelines and the environment variables managed outside of the resource are left untouched.
+	if err = meta.(client.GoCD).PatchEnvironmentChanges(ctx, envName, patch); err != nil {
+		return diag.Errorf("updating environment %s errored with: %v", envName, err)
 	}
 
-	log.Printf("nothing to update so skipping")
+	if patch.EnvironmentVariables != nil {
+		secureVariables := getPatchedSecureVariables(d.Get(utils.TerraformResourceSecureVariables), *patch.EnvironmentVariables)
+		if err = d.Set(utils.TerraformResourceSecureVariables, secureVariables); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureVariables, err)
+		}
+	}
 
-	return nil
+	return resourceEnvironmentRead(ctx, d, meta)
 }
 
-func resourceEnvironmentDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if id := d.Id(); len(id) == 0 {
 		return diag.Errorf("resource with the ID '%s' not found", id)
@@ -156,8 +166,8 @@ func resourceEnvironmentDelete(_ context.Context, d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceEnvironmentImport(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceEnvironmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	envName := utils.String(d.Id())
 	response, err := defaultConfig.GetEnvironment(envName)
@@ -173,17 +183,15 @@ func resourceEnvironmentImport(_ context.Context, d *schema.ResourceData, meta i
 		return nil, fmt.Errorf(settingAttrErrorTmp, err, utils.TerraformResourcePipelines)
 	}
 
-	flattenedEnvVars, err := utils.MapSlice(response.EnvVars)
-	if err != nil {
-		d.SetId("")
-
-		return nil, fmt.Errorf("errored while flattening environment variable obtained: %w", err)
-	}
-
+	flattenedEnvVars, secureVariables := flattenEnvironmentVariables(ctx, response.EnvVars, d.Get(utils.TerraformResourceEnvVar), []interface{}{}, true)
 	if err = d.Set(utils.TerraformResourceEnvVar, flattenedEnvVars); err != nil {
 		return nil, fmt.Errorf(settingAttrErrorTmp, err, utils.TerraformResourceEnvVar)
 	}
 
+	if err = d.Set(utils.TerraformResourceSecureVariables, secureVariables); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureVariables, err)
+	}
+
 	if err = d.Set(utils.TerraformResourceEtag, response.ETAG); err != nil {
 		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceEtag, err)
 	}
@@ -198,9 +206,156 @@ func getEnvironments(configs interface{}) ([]gocd.EnvVars, error) {
 		return nil, err
 	}
 
+	for index, envVar := range envVars {
+		// plain text value of the secure variable is present only when it is being written, GoCD would encrypt it again.
+		if envVar.Secure && len(envVar.Value) != 0 {
+			envVars[index].EncryptedValue = ""
+		}
+	}
+
 	return envVars, nil
 }
 
+// flattenEnvironmentVariables flattens the environment variables obtained from GoCD, along with the secure variables to be tracked.
+// Only the variables present in the state are flattened (all of them when flattenAll is set, ex: on import), so that the variables
+// managed outside of the resource (ex: by gocd_environment_variable) are left untouched. GoCD returns only the encrypted value of the
+// secure variables, so only their encrypted value is stored along with the checksum of the plain text value last written, which is
+// dropped when the encrypted value was changed outside of terraform so that the value declared in the config is written again.
+func flattenEnvironmentVariables(ctx context.Context, envVars []gocd.EnvVars, currentEnvVars, currentSecureVariables interface{}, flattenAll bool,
+) ([]map[string]interface{}, []map[string]interface{}) {
+	managed := make(map[string]bool)
+	for _, currentEnvVar := range currentEnvVars.(*schema.Set).List() {
+		managed[utils.String(currentEnvVar.(map[string]interface{})[utils.TerraformResourceName])] = true
+	}
+
+	tracked := make(map[string]map[string]interface{})
+	for _, currentSecureVariable := range currentSecureVariables.([]interface{}) {
+		secureVariable := currentSecureVariable.(map[string]interface{})
+		tracked[utils.String(secureVariable[utils.TerraformResourceName])] = secureVariable
+	}
+
+	flattenedEnvVars := make([]map[string]interface{}, 0, len(envVars))
+	secureVariables := make([]map[string]interface{}, 0)
+
+	for _, envVar := range envVars {
+		if !flattenAll && !managed[envVar.Name] {
+			continue
+		}
+
+		flattenedEnvVar := map[string]interface{}{
+			utils.TerraformResourceName:          envVar.Name,
+			utils.TerraformResourceSecure:        envVar.Secure,
+			utils.TerraformResourceValue:         envVar.Value,
+			utils.TerraformResourceENCValue:      envVar.EncryptedValue,
+			utils.TerraformResourceValueChecksum: "",
+		}
+
+		if envVar.Secure {
+			var checksum string
+			if secureVariable, isTracked := tracked[envVar.Name]; isTracked {
+				checksum = getSecureValueChecksumFromState(ctx, secureVariable, envVar.EncryptedValue)
+			}
+
+			flattenedEnvVar[utils.TerraformResourceValue] = ""
+			flattenedEnvVar[utils.TerraformResourceValueChecksum] = checksum
+
+			secureVariables = append(secureVariables, map[string]interface{}{
+				utils.TerraformResourceName:          envVar.Name,
+				utils.TerraformResourceENCValue:      envVar.EncryptedValue,
+				utils.TerraformResourceValueChecksum: checksum,
+			})
+		}
+
+		flattenedEnvVars = append(flattenedEnvVars, flattenedEnvVar)
+	}
+
+	return flattenedEnvVars, secureVariables
+}
+
+// hashEnvironmentVariable hashes the environment variable by its name, whether it is secure and its value. The secure variables are
+// hashed by the checksum of their plain text value instead when it is known, since the state holds only the checksum and not the value.
+func hashEnvironmentVariable(value interface{}) int {
+	envVar := value.(map[string]interface{})
+	secure, _ := envVar[utils.TerraformResourceSecure].(bool)
+	plainValue, _ := envVar[utils.TerraformResourceValue].(string)
+	encryptedValue, _ := envVar[utils.TerraformResourceENCValue].(string)
+	checksum, _ := envVar[utils.TerraformResourceValueChecksum].(string)
+
+	key := "value:" + plainValue + "\nencrypted_value:" + encryptedValue
+	if secure {
+		switch {
+		case len(plainValue) != 0:
+			key = "value_checksum:" + getSecureValueChecksum(plainValue)
+		case len(checksum) != 0:
+			key = "value_checksum:" + checksum
+		default:
+			key = "encrypted_value:" + encryptedValue
+		}
+	}
+
+	return schema.HashString(fmt.Sprintf("%v\n%t\n%s", envVar[utils.TerraformResourceName], secure, key))
+}
+
+// getEnvironmentSecureVariables returns the checksum of the plain text value of the secure variables being written to GoCD,
+// their encrypted value is tracked on the read that follows.
+func getEnvironmentSecureVariables(envVars []gocd.EnvVars) []map[string]interface{} {
+	secureVariables := make([]map[string]interface{}, 0)
+	for _, envVar := range envVars {
+		if !envVar.Secure || len(envVar.Value) == 0 {
+			continue
+		}
+
+		secureVariables = append(secureVariables, map[string]interface{}{
+			utils.TerraformResourceName:          envVar.Name,
+			utils.TerraformResourceENCValue:      "",
+			utils.TerraformResourceValueChecksum: getSecureValueChecksum(envVar.Value),
+		})
+	}
+
+	return secureVariables
+}
+
+// getSecureValueChecksumFromState returns the checksum of the secure value known to terraform. When the plain text value is
+// available (it was just written) its checksum is returned, otherwise the checksum saved in the state is retained unless the
+// encrypted value was changed outside of terraform, in which case the value has to be written again.
+func getSecureValueChecksumFromState(ctx context.Context, secureVar map[string]interface{}, encryptedValue string) string {
+	// the plain text value is present only on the single environment variable resource, not on the tracked secure variables.
+	if value, _ := secureVar[utils.TerraformResourceValue].(string); len(value) != 0 {
+		return getSecureValueChecksum(value)
+	}
+
+	if previousEncryptedValue := utils.String(secureVar[utils.TerraformResourceENCValue]); len(previousEncryptedValue) != 0 &&
+		previousEncryptedValue != encryptedValue {
+		tflog.Warn(ctx, "encrypted value of secure variable was changed outside of terraform", map[string]interface{}{"name": secureVar[utils.TerraformResourceName]})
+
+		return ""
+	}
+
+	return utils.String(secureVar[utils.TerraformResourceValueChecksum])
+}
+
+// getSecureValueChecksum returns the checksum of the plain text value of a secure variable, which is stored in the state instead of the value.
+func getSecureValueChecksum(value string) string {
+	// writing to a hash never errors, so the error is not expected here.
+	checksum, _ := utils.GetChecksum(value)
+
+	return checksum
+}
+
+// suppressSecureValueDiff suppresses the diff between the plain text value of a secure variable declared in the config and the
+// empty value in the state, when the checksum of the value matches the one stored in the state.
+func suppressSecureValueDiff(key, oldValue, newValue string, d *schema.ResourceData) bool {
+	if len(oldValue) != 0 || len(newValue) == 0 {
+		return false
+	}
+
+	// the checksum is computed, so it is read from the state.
+	previousChecksum, _ := d.GetChange(strings.TrimSuffix(key, utils.TerraformResourceValue) + utils.TerraformResourceValueChecksum)
+	checksum := utils.String(previousChecksum)
+
+	return len(checksum) != 0 && getSecureValueChecksum(newValue) == checksum
+}
+
 func getPipelines(configs interface{}) []gocd.Pipeline {
 	pipelines := make([]gocd.Pipeline, 0)
 	for _, pipeline := range configs.([]interface{}) {
@@ -210,33 +365,110 @@ func getPipelines(configs interface{}) []gocd.Pipeline {
 	return pipelines
 }
 
-func getEnvChanges(d *schema.ResourceData) (environmentChanges, error) {
-	var changes environmentChanges
-	oldVars, newVars := d.GetChange(utils.TerraformResourceEnvVar)
-	oldPipelines, newPipelines := d.GetChange(utils.TerraformResourcePipelines)
+// getEnvironmentPatch returns the pipelines and the environment variables to be added to and removed from the environment.
+// An environment variable that was changed is removed and added again.
+func getEnvironmentPatch(d *schema.ResourceData) (client.EnvironmentPatch, error) {
+	var patch client.EnvironmentPatch
 
-	envVars, err := getEnvironments(oldVars)
-	if err != nil {
-		return changes, fmt.Errorf("reading environment errored with %w", err)
+	if d.HasChange(utils.TerraformResourcePipelines) {
+		oldPipelines, newPipelines := d.GetChange(utils.TerraformResourcePipelines)
+		pipelines := getAddRemove(utils.GetSlice(oldPipelines.([]interface{})), utils.GetSlice(newPipelines.([]interface{})))
+		patch.Pipelines = &pipelines
 	}
 
-	changes.equal = true
-	changes.envVarsChanges = envVars
-	changes.pipelineChanges = getPipelines(oldPipelines)
+	if d.HasChange(utils.TerraformResourceEnvVar) {
+		oldVars, newVars := d.GetChange(utils.TerraformResourceEnvVar)
 
-	if !cmp.Equal(oldVars, newVars) {
-		envVars, err = getEnvironments(newVars)
+		oldEnvVars, err := getEnvironments(oldVars)
 		if err != nil {
-			return changes, fmt.Errorf("reading environment errored with %w", err)
+			return patch, fmt.Errorf("reading environment errored with %w", err)
+		}
+
+		newEnvVars, err := getEnvironments(newVars)
+		if err != nil {
+			return patch, fmt.Errorf("reading environment errored with %w", err)
+		}
+
+		envVars := getEnvironmentVariablesPatch(oldEnvVars, newEnvVars)
+		patch.EnvironmentVariables = &envVars
+	}
+
+	return patch, nil
+}
+
+// getAddRemove returns the entries present only in new to be added, and the ones present only in old to be removed.
+func getAddRemove(oldEntries, newEntries []string) client.AddRemove {
+	var addRemove client.AddRemove
+
+	for _, entry := range newEntries {
+		if !slices.Contains(oldEntries, entry) {
+			addRemove.Add = append(addRemove.Add, entry)
+		}
+	}
+
+	for _, entry := range oldEntries {
+		if !slices.Contains(newEntries, entry) {
+			addRemove.Remove = append(addRemove.Remove, entry)
+		}
+	}
+
+	return addRemove
+}
+
+// getEnvironmentVariablesPatch returns the environment variables added or changed from old to new, along with the names of
+// the ones removed or changed.
+func getEnvironmentVariablesPatch(oldEnvVars, newEnvVars []gocd.EnvVars) client.EnvironmentVariablesPatch {
+	previous := make(map[string]gocd.EnvVars)
+	for _, envVar := range oldEnvVars {
+		previous[envVar.Name] = envVar
+	}
+
+	patch := client.EnvironmentVariablesPatch{}
+	current := make(map[string]bool)
+
+	for _, envVar := range newEnvVars {
+		current[envVar.Name] = true
+
+		previousEnvVar, found := previous[envVar.Name]
+		if found && previousEnvVar == envVar {
+			continue
+		}
+
+		if found {
+			patch.Remove = append(patch.Remove, envVar.Name)
+		}
+
+		patch.Add = append(patch.Add, envVar)
+	}
+
+	for _, envVar := range oldEnvVars {
+		if !current[envVar.Name] {
+			patch.Remove = append(patch.Remove, envVar.Name)
 		}
-		changes.envVarsChanges = envVars
-		changes.equal = false
 	}
 
-	if !cmp.Equal(oldPipelines, newPipelines) {
-		changes.pipelineChanges = getPipelines(newPipelines)
-		changes.equal = false
+	return patch
+}
+
+// getPatchedSecureVariables returns the secure variables tracked after patching the environment variables, the ones written
+// are tracked by the checksum of their plain text value and the rest are retained as tracked.
+func getPatchedSecureVariables(currentSecureVariables interface{}, patch client.EnvironmentVariablesPatch) []map[string]interface{} {
+	written := make(map[string]bool)
+	for _, name := range patch.Remove {
+		written[name] = true
+	}
+
+	for _, envVar := range patch.Add {
+		written[envVar.Name] = true
+	}
+
+	secureVariables := make([]map[string]interface{}, 0)
+	for _, currentSecureVariable := range currentSecureVariables.([]interface{}) {
+		secureVariable := currentSecureVariable.(map[string]interface{})
+		if !written[utils.String(secureVariable[utils.TerraformResourceName])] {
+			secureVariables = append(secureVariables, secureVariable)
+		}
 	}
 
-	return changes, nil
+	return append(secureVariables, getEnvironmentSecureVariables(patch.Add)...)
 }
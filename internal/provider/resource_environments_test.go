@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func TestFlattenEnvironmentVariables(t *testing.T) {
+	declaredSecureVar := map[string]interface{}{"name": "TOKEN", "value": "secret", "encrypted_value": "", "secure": true}
+	checksum := getSecureValueChecksum("secret")
+
+	tests := []struct {
+		name                    string
+		envVars                 []gocd.EnvVars
+		declared                []interface{}
+		tracked                 []interface{}
+		flattenAll              bool
+		expectedEnvVars         []map[string]interface{}
+		expectedSecureVariables []map[string]interface{}
+	}{
+		{
+			name:            "plain variables are flattened as obtained from GoCD",
+			envVars:         []gocd.EnvVars{{Name: "REGION", Value: "eu"}},
+			declared:        []interface{}{map[string]interface{}{"name": "REGION", "value": "us", "encrypted_value": "", "secure": false}},
+			expectedEnvVars: []map[string]interface{}{{"name": "REGION", "value": "eu", "encrypted_value": "", "secure": false, "value_checksum": ""}},
+		},
+		{
+			name:            "variables not in the state are left to be managed outside of the resource",
+			envVars:         []gocd.EnvVars{{Name: "REGION", Value: "eu"}, {Name: "ZONE", Value: "a"}},
+			declared:        []interface{}{map[string]interface{}{"name": "REGION", "value": "eu", "encrypted_value": "", "secure": false}},
+			expectedEnvVars: []map[string]interface{}{{"name": "REGION", "value": "eu", "encrypted_value": "", "secure": false, "value_checksum": ""}},
+		},
+		{
+			name:       "all variables are flattened on import",
+			envVars:    []gocd.EnvVars{{Name: "REGION", Value: "eu"}, {Name: "TOKEN", EncryptedValue: "AES:one", Secure: true}},
+			flattenAll: true,
+			expectedEnvVars: []map[string]interface{}{
+				{"name": "REGION", "value": "eu", "encrypted_value": "", "secure": false, "value_checksum": ""},
+				{"name": "TOKEN", "value": "", "encrypted_value": "AES:one", "secure": true, "value_checksum": ""},
+			},
+			expectedSecureVariables: []map[string]interface{}{{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": ""}},
+		},
+		{
+			name:                    "secure variable just written is stored with its encrypted value and the checksum of its value",
+			envVars:                 []gocd.EnvVars{{Name: "TOKEN", EncryptedValue: "AES:one", Secure: true}},
+			declared:                []interface{}{declaredSecureVar},
+			tracked:                 []interface{}{map[string]interface{}{"name": "TOKEN", "encrypted_value": "", "value_checksum": checksum}},
+			expectedEnvVars:         []map[string]interface{}{{"name": "TOKEN", "value": "", "encrypted_value": "AES:one", "secure": true, "value_checksum": checksum}},
+			expectedSecureVariables: []map[string]interface{}{{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum}},
+		},
+		{
+			name:                    "secure variable with unchanged encrypted value retains the checksum",
+			envVars:                 []gocd.EnvVars{{Name: "TOKEN", EncryptedValue: "AES:one", Secure: true}},
+			declared:                []interface{}{declaredSecureVar},
+			tracked:                 []interface{}{map[string]interface{}{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum}},
+			expectedEnvVars:         []map[string]interface{}{{"name": "TOKEN", "value": "", "encrypted_value": "AES:one", "secure": true, "value_checksum": checksum}},
+			expectedSecureVariables: []map[string]interface{}{{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum}},
+		},
+		{
+			name:                    "secure variable changed outside of terraform drops the checksum",
+			envVars:                 []gocd.EnvVars{{Name: "TOKEN", EncryptedValue: "AES:two", Secure: true}},
+			declared:                []interface{}{declaredSecureVar},
+			tracked:                 []interface{}{map[string]interface{}{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum}},
+			expectedEnvVars:         []map[string]interface{}{{"name": "TOKEN", "value": "", "encrypted_value": "AES:two", "secure": true, "value_checksum": ""}},
+			expectedSecureVariables: []map[string]interface{}{{"name": "TOKEN", "encrypted_value": "AES:two", "value_checksum": ""}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tracked := test.tracked
+			if tracked == nil {
+				tracked = []interface{}{}
+			}
+
+			envVars, secureVariables := flattenEnvironmentVariables(context.Background(), test.envVars, schema.NewSet(hashEnvironmentVariable, test.declared),
+				tracked, test.flattenAll)
+
+			if d := cmp.Diff(test.expectedEnvVars, envVars); d != "" {
+				t.Errorf("environment variables mismatch (-expected +actual):\n%s", d)
+			}
+
+			expectedSecureVariables := test.expectedSecureVariables
+			if expectedSecureVariables == nil {
+				expectedSecureVariables = []map[string]interface{}{}
+			}
+
+			if d := cmp.Diff(expectedSecureVariables, secureVariables); d != "" {
+				t.Errorf("secure variables mismatch (-expected +actual):\n%s", d)
+			}
+		})
+	}
+}
+
+func TestEnvironmentVariablesDiff(t *testing.T) {
+	checksum := getSecureValueChecksum("secret")
+
+	tests := []struct {
+		name         string
+		declared     map[string]interface{}
+		expectedDiff bool
+	}{
+		{name: "secure value matching the checksum in the state", declared: map[string]interface{}{"name": "TOKEN", "value": "secret", "secure": true}},
+		{name: "secure value changed in the config", declared: map[string]interface{}{"name": "TOKEN", "value": "changed", "secure": true}, expectedDiff: true},
+		{
+			name:         "secure variable turned into a plain one",
+			declared:     map[string]interface{}{"name": "TOKEN", "value": "secret", "secure": false},
+			expectedDiff: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := resourceEnvironment().TestResourceData()
+			d.SetId("sample")
+
+			state := map[string]interface{}{
+				"name": "sample",
+				"environment_variables": []interface{}{
+					map[string]interface{}{"name": "TOKEN", "value": "", "encrypted_value": "AES:one", "secure": true, "value_checksum": checksum},
+				},
+			}
+
+			for key, value := range state {
+				if err := d.Set(key, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name": "sample", "environment_variables": []interface{}{test.declared},
+			})
+
+			diff, err := schema.InternalMap(resourceEnvironment().Schema).Diff(context.Background(), d.State(), config, nil, nil, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			hasDiff := false
+			if diff != nil {
+				for key := range diff.Attributes {
+					hasDiff = hasDiff || strings.HasPrefix(key, utils.TerraformResourceEnvVar+".")
+				}
+			}
+
+			if hasDiff != test.expectedDiff {
+				t.Errorf("expected diff of the environment variables: %t, got: %v", test.expectedDiff, diff)
+			}
+		})
+	}
+}
+
+func TestGetEnvironmentVariablesPatch(t *testing.T) {
+	oldEnvVars := []gocd.EnvVars{
+		{Name: "REGION", Value: "eu"},
+		{Name: "ZONE", Value: "a"},
+		{Name: "TOKEN", EncryptedValue: "AES:one", Secure: true},
+	}
+
+	newEnvVars := []gocd.EnvVars{
+		{Name: "REGION", Value: "us"},
+		{Name: "TOKEN", EncryptedValue: "AES:one", Secure: true},
+		{Name: "KEY", Value: "secret", Secure: true},
+	}
+
+	expected := client.EnvironmentVariablesPatch{
+		Add:    []gocd.EnvVars{{Name: "REGION", Value: "us"}, {Name: "KEY", Value: "secret", Secure: true}},
+		Remove: []string{"REGION", "ZONE"},
+	}
+
+	if d := cmp.Diff(expected, getEnvironmentVariablesPatch(oldEnvVars, newEnvVars)); d != "" {
+		t.Errorf("patch mismatch (-expected +actual):\n%s", d)
+	}
+}
+
+func TestGetEnvironmentSecureVariables(t *testing.T) {
+	envVars := []gocd.EnvVars{
+		{Name: "REGION", Value: "eu"},
+		{Name: "TOKEN", Value: "secret", Secure: true},
+		{Name: "KEY", EncryptedValue: "AES:one", Secure: true},
+	}
+
+	expected := []map[string]interface{}{
+		{utils.TerraformResourceName: "TOKEN", utils.TerraformResourceENCValue: "", utils.TerraformResourceValueChecksum: getSecureValueChecksum("secret")},
+	}
+
+	if d := cmp.Diff(expected, getEnvironmentSecureVariables(envVars)); d != "" {
+		t.Errorf("secure variables mismatch (-expected +actual):\n%s", d)
+	}
+}
+
+func TestGetSecureValueChecksumFromState(t *testing.T) {
+	checksum := getSecureValueChecksum("secret")
+
+	tests := []struct {
+		name           string
+		secureVar      map[string]interface{}
+		encryptedValue string
+		expected       string
+	}{
+		{
+			name:           "checksum of the plain text value when present",
+			secureVar:      map[string]interface{}{"name": "TOKEN", "value": "secret", "encrypted_value": "AES:one", "value_checksum": ""},
+			encryptedValue: "AES:two",
+			expected:       checksum,
+		},
+		{
+			name:           "checksum is retained when the encrypted value is unchanged",
+			secureVar:      map[string]interface{}{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum},
+			encryptedValue: "AES:one",
+			expected:       checksum,
+		},
+		{
+			name:           "checksum is retained when the encrypted value was not known yet",
+			secureVar:      map[string]interface{}{"name": "TOKEN", "encrypted_value": "", "value_checksum": checksum},
+			encryptedValue: "AES:one",
+			expected:       checksum,
+		},
+		{
+			name:           "checksum is dropped when the encrypted value was changed outside of terraform",
+			secureVar:      map[string]interface{}{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum},
+			encryptedValue: "AES:two",
+			expected:       "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := getSecureValueChecksumFromState(context.Background(), test.secureVar, test.encryptedValue); actual != test.expected {
+				t.Errorf("getSecureValueChecksumFromState() = '%s', expected '%s'", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestSuppressSecureValueDiff(t *testing.T) {
+	checksum := getSecureValueChecksum("secret")
+
+	tests := []struct {
+		name     string
+		checksum string
+		oldValue string
+		newValue string
+		expected bool
+	}{
+		{name: "value matching the checksum in the state", checksum: checksum, newValue: "secret", expected: true},
+		{name: "value not matching the checksum in the state", checksum: checksum, newValue: "changed", expected: false},
+		{name: "no checksum in the state", newValue: "secret", expected: false},
+		{name: "value being removed", checksum: checksum, expected: false},
+		{name: "plain value present in the state", checksum: checksum, oldValue: "plain", newValue: "secret", expected: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := resourceEnvironmentVariable().Data(&terraform.InstanceState{
+				ID: "sample", Attributes: map[string]string{utils.TerraformResourceValueChecksum: test.checksum},
+			})
+
+			if actual := suppressSecureValueDiff(utils.TerraformResourceValue, test.oldValue, test.newValue, d); actual != test.expected {
+				t.Errorf("suppressSecureValueDiff() = %t, expected %t", actual, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	maintenanceModeID           = "maintenance_mode"
+	defaultMaintenancePoll      = "10s"
+	maintenanceStateJobsRunning = "jobs_running"
+	maintenanceStateDrained     = "drained"
+)
+
+func resourceMaintenanceMode() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceModeCreate,
+		ReadContext:   resourceMaintenanceModeRead,
+		UpdateContext: resourceMaintenanceModeUpdate,
+		DeleteContext: resourceMaintenanceModeDelete,
+		Timeouts:      resourceTimeouts(true),
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     true,
+				Description: "Whether maintenance mode should be enabled on GoCD server. Defaults to `true`.",
+			},
+			"wait_for_jobs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: false,
+				Default:  false,
+				Description: "Enabling this waits after enabling maintenance mode until no jobs are building on GoCD server, " +
+					"the wait is limited by the `create`/`update` timeouts.",
+			},
+			"poll_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         false,
+				Default:          defaultMaintenancePoll,
+				ValidateDiagFunc: validateDuration,
+				Description:      "Interval between the checks made for the running jobs while waiting for them, as a duration. Defaults to `10s`.",
+			},
+			"has_running_systems": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether any jobs or material updates are still running on GoCD server.",
+			},
+			"building_jobs": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of jobs that are building on GoCD server.",
+			},
+			"scheduled_jobs": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of jobs that are scheduled and are waiting for an agent on GoCD server.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceMaintenanceModeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	if diags := checkFeature(ctx, meta, client.FeatureMaintenanceMode); diags.HasError() {
+		return diags
+	}
+
+	if err := setMaintenanceMode(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	d.SetId(maintenanceModeID)
+
+	return resourceMaintenanceModeRead(ctx, d, meta)
+}
+
+func resourceMaintenanceModeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	response, err := meta.(client.GoCD).GetMaintenanceModeInfo(ctx)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	maintenanceInfo := map[string]interface{}{
+		utils.TerraformResourceEnabled:           response.Enabled,
+		utils.TerraformResourceHasRunningSystems: response.Attributes.HasRunningSystems,
+		utils.TerraformResourceBuildingJobs:      len(response.Attributes.RunningSystems.BuildingJobs),
+		utils.TerraformResourceScheduledJobs:     len(response.Attributes.RunningSystems.ScheduledJobs),
+	}
+
+	for key, value := range maintenanceInfo {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceMaintenanceModeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChange(utils.TerraformResourceEnabled) && !d.HasChange(utils.TerraformResourceWaitForJobs) {
+		tflog.Debug(ctx, "nothing to update so skipping")
+
+		return nil
+	}
+
+	if err := setMaintenanceMode(ctx, d, meta, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	return resourceMaintenanceModeRead(ctx, d, meta)
+}
+
+func resourceMaintenanceModeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+	defaultConfig := goCDClient.WithContext(ctx)
+
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	response, err := goCDClient.GetMaintenanceModeInfo(ctx)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	if response.Enabled {
+		if err = defaultConfig.DisableMaintenanceMode(); err != nil {
+			return diag.Errorf("disabling maintenance mode errored with: %v", err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// setMaintenanceMode enables or disables maintenance mode as configured, and waits for the running jobs when it is enabled with `wait_for_jobs`.
+func setMaintenanceMode(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	goCDClient := meta.(client.GoCD)
+	defaultConfig := goCDClient.WithContext(ctx)
+	enabled := utils.Bool(d.Get(utils.TerraformResourceEnabled))
+
+	response, err := goCDClient.GetMaintenanceModeInfo(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case enabled && !response.Enabled:
+		tflog.Info(ctx, "enabling maintenance mode on GoCD server")
+
+		if err = defaultConfig.EnableMaintenanceMode(); err != nil {
+			return fmt.Errorf("enabling maintenance mode errored with: %w", err)
+		}
+	case !enabled && response.Enabled:
+		tflog.Info(ctx, "disabling maintenance mode on GoCD server")
+
+		if err = defaultConfig.DisableMaintenanceMode(); err != nil {
+			return fmt.Errorf("disabling maintenance mode errored with: %w", err)
+		}
+	}
+
+	if !enabled || !utils.Bool(d.Get(utils.TerraformResourceWaitForJobs)) {
+		return nil
+	}
+
+	pollInterval, err := time.ParseDuration(utils.String(d.Get(utils.TerraformResourcePollInterval)))
+	if err != nil {
+		return fmt.Errorf("parsing poll interval errored with: %w", err)
+	}
+
+	return waitForRunningJobs(ctx, goCDClient.GetMaintenanceModeInfo, timeout, pollInterval)
+}
+
+// waitForRunningJobs waits until no jobs are building on GoCD server, or the timeout is hit.
+// Scheduled jobs are only reported, since they are not assigned to agents while GoCD server is in maintenance mode.
+func waitForRunningJobs(
+	ctx context.Context, getMaintenanceModeInfo func(context.Context) (client.MaintenanceModeInfo, error), timeout, pollInterval time.Duration,
+) error {
+	stateConf := &retry.StateChangeConf{
+		Pending:      []string{maintenanceStateJobsRunning},
+		Target:       []string{maintenanceStateDrained},
+		Timeout:      timeout,
+		PollInterval: pollInterval,
+		Refresh: func() (interface{}, string, error) {
+			response, err := getMaintenanceModeInfo(ctx)
+			if err != nil {
+				return nil, "", err
+			}
+
+			runningSystems := response.Attributes.RunningSystems
+			if len(runningSystems.BuildingJobs) == 0 {
+				if len(runningSystems.ScheduledJobs) != 0 {
+					tflog.Info(ctx, "no jobs are building on GoCD server, scheduled jobs would be assigned once maintenance mode is disabled",
+						map[string]interface{}{"scheduled_jobs": len(runningSystems.ScheduledJobs)})
+				}
+
+				return response, maintenanceStateDrained, nil
+			}
+
+			tflog.Info(ctx, "waiting for the jobs building on GoCD server to complete", map[string]interface{}{
+				"building_jobs":  len(runningSystems.BuildingJobs),
+				"scheduled_jobs": len(runningSystems.ScheduledJobs),
+			})
+
+			return response, maintenanceStateJobsRunning, nil
+		},
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the jobs on GoCD server to complete errored with: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+)
+
+type maintenanceModeClient struct {
+	responses []client.RunningSystems
+	calls     int
+}
+
+func (c *maintenanceModeClient) GetMaintenanceModeInfo(context.Context) (client.MaintenanceModeInfo, error) {
+	response := c.responses[c.calls]
+	if c.calls < len(c.responses)-1 {
+		c.calls++
+	}
+
+	return client.MaintenanceModeInfo{Attributes: client.MaintenanceModeAttributes{RunningSystems: response}}, nil
+}
+
+func TestWaitForRunningJobs(t *testing.T) {
+	job := client.MaintenanceJob{PipelineName: "build", StageName: "test", Name: "unit"}
+
+	tests := []struct {
+		name          string
+		responses     []client.RunningSystems
+		expectErr     bool
+		expectedCalls int
+	}{
+		{
+			name:      "completes when no jobs are running",
+			responses: []client.RunningSystems{{}},
+		},
+		{
+			name:      "does not wait for the scheduled jobs",
+			responses: []client.RunningSystems{{ScheduledJobs: []client.MaintenanceJob{job}}},
+		},
+		{
+			name:          "waits for the building jobs to complete",
+			responses:     []client.RunningSystems{{BuildingJobs: []client.MaintenanceJob{job}}, {ScheduledJobs: []client.MaintenanceJob{job}}},
+			expectedCalls: 1,
+		},
+		{
+			name:      "times out when the jobs keep building",
+			responses: []client.RunningSystems{{BuildingJobs: []client.MaintenanceJob{job}}},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			defaultConfig := &maintenanceModeClient{responses: test.responses}
+
+			err := waitForRunningJobs(context.Background(), defaultConfig.GetMaintenanceModeInfo, 500*time.Millisecond, 10*time.Millisecond)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+
+			if !test.expectErr && defaultConfig.calls != test.expectedCalls {
+				t.Errorf("expected to wait until response %d, waited until %d", test.expectedCalls, defaultConfig.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceMaterialUpdateTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaterialUpdateTriggerCreate,
+		ReadContext:   resourceMaterialUpdateTriggerRead,
+		DeleteContext: resourceMaterialUpdateTriggerDelete,
+		Timeouts:      resourceTimeouts(false),
+		Schema: map[string]*schema.Schema{
+			"fingerprint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The fingerprint of the material whose update should be triggered, ex: `fingerprint` of the material of `gocd_config_repository`.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values, changing any of them triggers the update of the material again (ex: the revision of a commit pushed by terraform).",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The message returned by GoCD on triggering the update of the material.",
+			},
+		},
+	}
+}
+
+func resourceMaterialUpdateTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	fingerprint := utils.String(d.Get(utils.TerraformResourceFingerprint))
+
+	message := ""
+
+	response, err := defaultConfig.MaterialTriggerUpdate(fingerprint)
+
+	switch {
+	case isMaterialUpdateInProgress(err):
+		tflog.Info(ctx, "update of the material is already in progress, not triggering it again", map[string]interface{}{"fingerprint": fingerprint})
+
+		message = "update of the material is already in progress"
+	case err != nil:
+		return diag.Errorf("triggering update of material '%s' errored with: %v", fingerprint, err)
+	default:
+		message = response[utils.TerraformResourceMessage]
+	}
+
+	if err = d.Set(utils.TerraformResourceMessage, message); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceMessage, err)
+	}
+
+	id, err := utils.GetRandomID()
+	if err != nil {
+		return diag.Errorf("errored while fetching randomID %v", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func resourceMaterialUpdateTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	fingerprint := utils.String(d.Get(utils.TerraformResourceFingerprint))
+
+	materials, err := defaultConfig.GetMaterials()
+	if err != nil {
+		return diag.Errorf("getting materials errored with: %v", err)
+	}
+
+	if _, found := getMaterial(materials, fingerprint); !found {
+		tflog.Warn(ctx, "material is no longer known to GoCD, removing the trigger from state", map[string]interface{}{"fingerprint": fingerprint})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceMaterialUpdateTriggerDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// isMaterialUpdateInProgress reports whether GoCD rejected the trigger since the material is already being updated (409 Conflict).
+func isMaterialUpdateInProgress(err error) bool {
+	return client.StatusCode(err) == http.StatusConflict
+}
@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+var notificationFilterEvents = []string{"Breaks", "Fixed", "Fails", "Passes", "All", "Cancelled"}
+
+func resourceNotificationFilter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNotificationFilterCreate,
+		ReadContext:   resourceNotificationFilterRead,
+		UpdateContext: resourceNotificationFilterUpdate,
+		DeleteContext: resourceNotificationFilterDelete,
+		Timeouts:      resourceTimeouts(true),
+		Schema: map[string]*schema.Schema{
+			"pipeline": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the pipeline whose events should be notified, `[Any Pipeline]` to be notified of all the pipelines.",
+			},
+			"stage": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				Description: "The name of the stage whose events should be notified, `[Any Stage]` to be notified of all the stages of the pipeline.",
+			},
+			"event": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Computed:         false,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(notificationFilterEvents, false)),
+				Description:      "The event of the stage to be notified, should be one of `Breaks`, `Fixed`, `Fails`, `Passes`, `All` or `Cancelled`.",
+			},
+			"match_commits": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    false,
+				Default:     false,
+				Description: "Setting this to true notifies only when the changes in the materials were committed by the user. Defaults to `false`.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+	}
+}
+
+func resourceNotificationFilterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	response, err := goCDClient.CreateNotificationFilter(ctx, getNotificationFilter(d))
+	if err != nil {
+		return diag.Errorf("creating notification filter errored with: %v", err)
+	}
+
+	d.SetId(strconv.Itoa(response.ID))
+
+	return resourceNotificationFilterRead(ctx, d, meta)
+}
+
+func resourceNotificationFilterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid ID '%s', it should be the numeric ID of the notification filter", d.Id())
+	}
+
+	response, err := goCDClient.GetNotificationFilter(ctx, id)
+	if err != nil {
+		if client.IsNotFound(err) {
+			tflog.Warn(ctx, "notification filter was deleted outside of terraform, removing it from state", map[string]interface{}{"id": id})
+			d.SetId("")
+
+			return nil
+		}
+
+		return diag.Errorf("getting notification filter '%d' errored with: %v", id, err)
+	}
+
+	notificationFilter := map[string]interface{}{
+		utils.TerraformResourcePipeline:     response.Pipeline,
+		utils.TerraformResourceStage:        response.Stage,
+		utils.TerraformResourceEvent:        response.Event,
+		utils.TerraformResourceMatchCommits: response.MatchCommits,
+	}
+
+	for key, value := range notificationFilter {
+		if err = d.Set(key, value); err != nil {
+			return diag.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceNotificationFilterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	if !d.HasChanges(utils.TerraformResourcePipeline, utils.TerraformResourceStage, utils.TerraformResourceEvent, utils.TerraformResourceMatchCommits) {
+		tflog.Debug(ctx, "nothing to update so skipping")
+
+		return nil
+	}
+
+	filter := getNotificationFilter(d)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid ID '%s', it should be the numeric ID of the notification filter", d.Id())
+	}
+
+	filter.ID = id
+
+	if _, err = goCDClient.UpdateNotificationFilter(ctx, filter); err != nil {
+		return diag.Errorf("updating notification filter '%d' errored with: %v", id, err)
+	}
+
+	return resourceNotificationFilterRead(ctx, d, meta)
+}
+
+func resourceNotificationFilterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	goCDClient := meta.(client.GoCD)
+
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.Errorf("invalid ID '%s', it should be the numeric ID of the notification filter", d.Id())
+	}
+
+	if err = goCDClient.DeleteNotificationFilter(ctx, id); err != nil && !client.IsNotFound(err) {
+		return diag.Errorf("deleting notification filter '%d' errored with: %v", id, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getNotificationFilter(d *schema.ResourceData) client.NotificationFilter {
+	return client.NotificationFilter{
+		Pipeline:     utils.String(d.Get(utils.TerraformResourcePipeline)),
+		Stage:        utils.String(d.Get(utils.TerraformResourceStage)),
+		Event:        utils.String(d.Get(utils.TerraformResourceEvent)),
+		MatchCommits: utils.Bool(d.Get(utils.TerraformResourceMatchCommits)),
+	}
+}
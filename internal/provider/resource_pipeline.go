@@ -3,23 +3,36 @@ package provider
 import (
 	"context"
 	"encoding/json"
-	"log"
+	"fmt"
+	"io"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/common/content"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v3"
 )
 
+// contentLogger is required by the content type detection of the pipeline config, its logs are of no use to terraform so they are discarded.
+var contentLogger = func() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return logger
+}()
+
 func resourcePipeline() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePipelineCreate,
 		ReadContext:   resourcePipelineRead,
 		UpdateContext: resourcePipelineUpdate,
 		DeleteContext: resourcePipelineDelete,
+		Timeouts:      resourceTimeouts(true),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -33,8 +46,8 @@ func resourcePipeline() *schema.Resource {
 				Optional:    false,
 				Required:    true,
 				Computed:    false,
-				ForceNew:    true,
-				Description: "Name of the pipeline group that this pipeline should be part of.",
+				ForceNew:    false,
+				Description: "Name of the pipeline group that this pipeline should be part of, changing it moves the pipeline to the new group in place.",
 			},
 			"config": {
 				Type:        schema.TypeString,
@@ -65,6 +78,9 @@ func resourcePipeline() *schema.Resource {
 				ForceNew:    true,
 				Description: "Would be set to true when pipeline config declared under `config` is of type yaml.",
 			},
+			"secure_variables": secureVariablesSchema("The secure environment variables of the pipeline and its stages and jobs, " +
+				"plain text value of these variables declared under `config` is sent to GoCD only while writing, only their encrypted value " +
+				"and checksum is tracked. Variables of the stages and jobs are named as `<stage>/<name>` and `<stage>/<job>/<name>`."),
 			"etag": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -72,11 +88,12 @@ func resourcePipeline() *schema.Resource {
 				Description: "Etag used to track the pipeline config",
 			},
 		},
+		CustomizeDiff: customdiff.All(resourcePipelineCustomizeDiff, checkPermission(client.PermissionPipelineGroup, utils.TerraformResourceGroup, false)),
 	}
 }
 
 func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
@@ -99,11 +116,11 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta in
 	}
 
 	obj := content.Object(utils.String(d.Get(utils.TerraformResourceConfig)))
-	logger := logrus.New()
-	obj.CheckFileType(logger)
+	objType := obj.CheckFileType(contentLogger)
+	tflog.Debug(ctx, "identified the type of pipeline config", map[string]interface{}{"pipeline": id, "type": objType})
 
 	var configMap map[string]interface{}
-	switch objType := obj.CheckFileType(logger); objType {
+	switch objType {
 	case content.FileTypeJSON:
 		if err := json.Unmarshal([]byte(obj.String()), &configMap); err != nil {
 			return diag.Errorf("decoding pipeline config errored with: %v", err)
@@ -127,17 +144,23 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, meta in
 			"current values: 'attribute:%s config:%s'", id, pipelineCfg.Config["name"].(string))
 	}
 
+	meta.(client.GoCD).InvalidatePipelineConfigs()
+
 	if _, err := defaultConfig.CreatePipeline(pipelineCfg); err != nil {
 		return diag.Errorf("creating pipeline '%s' errored with: %v", id, err)
 	}
 
+	if err := d.Set(utils.TerraformResourceSecureVariables, getPipelineSecureVariables(pipelineCfg.Config)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureVariables, err)
+	}
+
 	d.SetId(id)
 
 	return resourcePipelineRead(ctx, d, meta)
 }
 
-func resourcePipelineRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	name := utils.String(d.Get(utils.TerraformResourceName))
 	response, err := defaultConfig.GetPipelineConfig(name)
@@ -145,6 +168,11 @@ func resourcePipelineRead(_ context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("getting pipeline config %s errored with: %v", name, err)
 	}
 
+	secureVariables := flattenPipelineSecureVariables(ctx, response.Config, d.Get(utils.TerraformResourceSecureVariables))
+	if err = d.Set(utils.TerraformResourceSecureVariables, secureVariables); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureVariables, err)
+	}
+
 	if err = d.Set(utils.TerraformResourceEtag, response.ETAG); err != nil {
 		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceEtag, err)
 	}
@@ -153,10 +181,10 @@ func resourcePipelineRead(_ context.Context, d *schema.ResourceData, meta interf
 }
 
 func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
-	if !d.HasChanges(utils.TerraformResourceConfig) {
-		log.Printf("nothing to update so skipping")
+	if !d.HasChanges(utils.TerraformResourceConfig, utils.TerraformResourceGroup, utils.TerraformResourceSecureVariables) {
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -183,15 +211,49 @@ func resourcePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		pluginConfig.Config = configMap
 	}
 
-	if _, err := defaultConfig.UpdatePipelineConfig(pluginConfig); err != nil {
+	if d.HasChange(utils.TerraformResourceGroup) {
+		oldGroup, _ := d.GetChange(utils.TerraformResourceGroup)
+		tflog.Info(ctx, "moving pipeline to a different group", map[string]interface{}{"pipeline": pluginConfig.Name, "from": utils.String(oldGroup), "to": pluginConfig.Group})
+	}
+
+	var baseConfigMap map[string]interface{}
+
+	oldConfig, _ := d.GetChange(utils.TerraformResourceConfig)
+	if err := yaml.Unmarshal([]byte(utils.String(oldConfig)), &baseConfigMap); err != nil {
+		return diag.Errorf("decoding pipeline config errored with: %v", err)
+	}
+
+	meta.(client.GoCD).InvalidatePipelineConfigs()
+
+	err := updateOnConflict(ctx, meta, "pipeline", pluginConfig.Name, baseConfigMap, pluginConfig.Config, pluginConfig.ETAG,
+		func() (map[string]interface{}, string, error) {
+			response, err := meta.(client.GoCD).GetPipelineConfigFields(ctx, pluginConfig.Name)
+
+			return response.Fields, response.ETAG, err
+		},
+		func(config map[string]interface{}, etag string) error {
+			_, err := defaultConfig.UpdatePipelineConfig(gocd.PipelineConfig{
+				Name:   pluginConfig.Name,
+				Group:  pluginConfig.Group,
+				Config: config,
+				ETAG:   etag,
+			})
+
+			return err
+		})
+	if err != nil {
 		return diag.Errorf("updating pipeline '%s' errored with: %v", pluginConfig.Name, err)
 	}
 
+	if err = d.Set(utils.TerraformResourceSecureVariables, getPipelineSecureVariables(pluginConfig.Config)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceSecureVariables, err)
+	}
+
 	return resourcePipelineRead(ctx, d, meta)
 }
 
-func resourcePipelineDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	id := d.Id()
 	if len(d.Id()) == 0 {
@@ -200,6 +262,8 @@ func resourcePipelineDelete(_ context.Context, d *schema.ResourceData, meta inte
 
 	name := utils.String(d.Get(utils.TerraformResourceName))
 
+	meta.(client.GoCD).InvalidatePipelineConfigs()
+
 	err := defaultConfig.DeletePipeline(name)
 	if err != nil {
 		return diag.Errorf("deleting pipeline %s errored with: %v", name, err)
@@ -209,3 +273,155 @@ func resourcePipelineDelete(_ context.Context, d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+// resourcePipelineCustomizeDiff plans an update of the pipeline when the plain text value of any of its secure variables declared
+// under `config` no longer matches the checksum tracked in the state, ex: the encrypted value was changed outside of terraform.
+// It also reports the pipeline being claimed by a different group under any of the gocd_pipeline_group resources.
+func resourcePipelineCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.NewValueKnown(utils.TerraformResourceName) && d.NewValueKnown(utils.TerraformResourceGroup) {
+		if err := claimPipeline(meta, utils.String(d.Get(utils.TerraformResourceName)), utils.String(d.Get(utils.TerraformResourceGroup))); err != nil {
+			return err
+		}
+	}
+
+	if len(d.Id()) == 0 {
+		return nil
+	}
+
+	if d.HasChange(utils.TerraformResourceConfig) {
+		return d.SetNewComputed(utils.TerraformResourceSecureVariables)
+	}
+
+	var configMap map[string]interface{}
+	if err := yaml.Unmarshal([]byte(utils.String(d.Get(utils.TerraformResourceConfig))), &configMap); err != nil {
+		return fmt.Errorf("decoding pipeline config errored with: %w", err)
+	}
+
+	checksums := make(map[string]string)
+	for _, secureVariable := range d.Get(utils.TerraformResourceSecureVariables).([]interface{}) {
+		variable := secureVariable.(map[string]interface{})
+		checksums[utils.String(variable[utils.TerraformResourceName])] = utils.String(variable[utils.TerraformResourceValueChecksum])
+	}
+
+	for _, secureVariable := range getPipelineSecureVariables(configMap) {
+		name := utils.String(secureVariable[utils.TerraformResourceName])
+		if checksums[name] != secureVariable[utils.TerraformResourceValueChecksum] {
+			tflog.Info(ctx, "value of secure variable of the pipeline has to be written again", map[string]interface{}{"name": name, "pipeline": d.Id()})
+
+			return d.SetNewComputed(utils.TerraformResourceSecureVariables)
+		}
+	}
+
+	return nil
+}
+
+// getPipelineSecureVariables returns the checksum of the plain text value of the secure variables declared in the pipeline config.
+func getPipelineSecureVariables(config map[string]interface{}) []map[string]interface{} {
+	secureVariables := make([]map[string]interface{}, 0)
+	for _, envVar := range getPipelineEnvironmentVariables(config) {
+		value := utils.String(envVar[utils.TerraformResourceValue])
+		if !utils.Bool(envVar[utils.TerraformResourceSecure]) || len(value) == 0 {
+			continue
+		}
+
+		secureVariables = append(secureVariables, map[string]interface{}{
+			utils.TerraformResourceName:          envVar[utils.TerraformResourceName],
+			utils.TerraformResourceENCValue:      "",
+			utils.TerraformResourceValueChecksum: getSecureValueChecksum(value),
+		})
+	}
+
+	return secureVariables
+}
+
+// flattenPipelineSecureVariables flattens the secure variables of the pipeline config obtained from GoCD, retaining the checksum
+// tracked in the state as long as the encrypted value in GoCD is unchanged.
+func flattenPipelineSecureVariables(ctx context.Context, config map[string]interface{}, currentSecureVariables interface{}) []map[string]interface{} {
+	current := make(map[string]map[string]interface{})
+	for _, currentSecureVariable := range currentSecureVariables.([]interface{}) {
+		secureVariable := currentSecureVariable.(map[string]interface{})
+		current[utils.String(secureVariable[utils.TerraformResourceName])] = secureVariable
+	}
+
+	secureVariables := make([]map[string]interface{}, 0)
+	for _, envVar := range getPipelineEnvironmentVariables(config) {
+		if !utils.Bool(envVar[utils.TerraformResourceSecure]) {
+			continue
+		}
+
+		name := utils.String(envVar[utils.TerraformResourceName])
+		encryptedValue := utils.String(envVar[utils.TerraformResourceENCValue])
+
+		var checksum string
+		if currentSecureVariable, ok := current[name]; ok {
+			checksum = getSecureValueChecksumFromState(ctx, currentSecureVariable, encryptedValue)
+		}
+
+		secureVariables = append(secureVariables, map[string]interface{}{
+			utils.TerraformResourceName:          name,
+			utils.TerraformResourceENCValue:      encryptedValue,
+			utils.TerraformResourceValueChecksum: checksum,
+		})
+	}
+
+	return secureVariables
+}
+
+// getPipelineEnvironmentVariables returns the environment variables declared at the pipeline, stage and job level of the pipeline config.
+// Variables of the stages and jobs are named after the stage and job they are declared in (ex: `<stage>/<job>/<name>`), as the same name
+// can be used at different levels.
+func getPipelineEnvironmentVariables(config map[string]interface{}) []map[string]interface{} {
+	envVars := getConfigEnvironmentVariables(config, "")
+
+	for _, stage := range getConfigObjects(config, utils.TerraformResourceStages) {
+		stageName, _ := stage[utils.TerraformResourceName].(string)
+		envVars = append(envVars, getConfigEnvironmentVariables(stage, stageName+"/")...)
+
+		for _, job := range getConfigObjects(stage, utils.TerraformResourceJobs) {
+			jobName, _ := job[utils.TerraformResourceName].(string)
+			envVars = append(envVars, getConfigEnvironmentVariables(job, stageName+"/"+jobName+"/")...)
+		}
+	}
+
+	return envVars
+}
+
+// getConfigEnvironmentVariables returns a copy of the environment variables declared in the config object with their names prefixed,
+// the config object itself is left untouched as it is sent to GoCD.
+func getConfigEnvironmentVariables(config map[string]interface{}, prefix string) []map[string]interface{} {
+	envVars := make([]map[string]interface{}, 0)
+	for _, configEnvVar := range getConfigObjects(config, utils.TerraformResourceEnvVar) {
+		envVar := map[string]interface{}{utils.TerraformResourceSecure: false}
+		if secure, ok := configEnvVar[utils.TerraformResourceSecure].(bool); ok {
+			envVar[utils.TerraformResourceSecure] = secure
+		}
+
+		for _, key := range []string{utils.TerraformResourceName, utils.TerraformResourceValue, utils.TerraformResourceENCValue} {
+			value, _ := configEnvVar[key].(string)
+			envVar[key] = value
+		}
+
+		envVar[utils.TerraformResourceName] = prefix + envVar[utils.TerraformResourceName].(string)
+
+		envVars = append(envVars, envVar)
+	}
+
+	return envVars
+}
+
+func getConfigObjects(config map[string]interface{}, key string) []map[string]interface{} {
+	objects := make([]map[string]interface{}, 0)
+
+	configObjects, ok := config[key].([]interface{})
+	if !ok {
+		return objects
+	}
+
+	for _, configObject := range configObjects {
+		if object, ok := configObject.(map[string]interface{}); ok {
+			objects = append(objects, object)
+		}
+	}
+
+	return objects
+}
@@ -2,12 +2,13 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -17,6 +18,7 @@ func resourcePipelineGroup() *schema.Resource {
 		ReadContext:   resourcePipelineGroupRead,
 		UpdateContext: resourcePipelineGroupUpdate,
 		DeleteContext: resourcePipelineGroupDelete,
+		Timeouts:      resourceTimeouts(true),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -29,14 +31,14 @@ func resourcePipelineGroup() *schema.Resource {
 				Type:        schema.TypeList,
 				Optional:    true,
 				ForceNew:    false,
-				Description: "List of pipelines to be associated with pipeline group.",
+				Description: "List of pipelines to be associated with pipeline group, pipelines added here are moved from their current group in place.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"authorization": {
 				Type:        schema.TypeSet,
 				Optional:    true,
 				ForceNew:    false,
-				Description: "The authorization configuration for the pipeline group.",
+				Description: "The authorization configuration for the pipeline group. When not set, authorization of the pipeline group is left untouched on updates so that it can be managed by `gocd_pipeline_group_permission`.",
 				Elem:        authConfigSchema(),
 			},
 			"etag": {
@@ -46,11 +48,12 @@ func resourcePipelineGroup() *schema.Resource {
 				Description: "Etag used to track the pipeline group.",
 			},
 		},
+		CustomizeDiff: customdiff.All(resourcePipelineGroupCustomizeDiff, checkPermission(client.PermissionPipelineGroup, utils.TerraformResourceName, true)),
 	}
 }
 
 func resourcePipelineGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
@@ -68,12 +71,7 @@ func resourcePipelineGroupCreate(ctx context.Context, d *schema.ResourceData, me
 		Authorization: getPipelineGroupAuthorizationConfig(d.Get(utils.TerraformResourceAuthorization)),
 	}
 
-	out, err := json.MarshalIndent(cfg, " ", " ")
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	log.Printf("PipelineGroup: %s", string(out))
+	tflog.Debug(ctx, "creating pipeline group", map[string]interface{}{"pipeline_group": cfg.Name})
 
 	if err := defaultConfig.CreatePipelineGroup(cfg); err != nil {
 		return diag.Errorf("creating pipeline group '%s' errored with %v", cfg.Name, err)
@@ -84,8 +82,8 @@ func resourcePipelineGroupCreate(ctx context.Context, d *schema.ResourceData, me
 	return resourcePipelineGroupRead(ctx, d, meta)
 }
 
-func resourcePipelineGroupRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourcePipelineGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	name := utils.String(d.Get(utils.TerraformResourceName))
 	response, err := defaultConfig.GetPipelineGroup(name)
@@ -101,10 +99,10 @@ func resourcePipelineGroupRead(_ context.Context, d *schema.ResourceData, meta i
 }
 
 func resourcePipelineGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.HasChange(utils.TerraformResourceAuthorization) && !d.HasChange(utils.TerraformResourcePipelines) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -116,15 +114,64 @@ func resourcePipelineGroupUpdate(ctx context.Context, d *schema.ResourceData, me
 		ETAG:          utils.String(d.Get(utils.TerraformResourceEtag)),
 	}
 
-	if _, err := defaultConfig.UpdatePipelineGroup(cfg); err != nil {
+	if d.HasChange(utils.TerraformResourcePipelines) {
+		oldPipelines, newPipelines := d.GetChange(utils.TerraformResourcePipelines)
+		existingPipelines := utils.GetSlice(oldPipelines.([]interface{}))
+		for _, pipeline := range utils.GetSlice(newPipelines.([]interface{})) {
+			if utils.Contains(existingPipelines, pipeline) {
+				continue
+			}
+
+			if err := movePipelineToGroup(ctx, meta, pipeline, cfg.Name); err != nil {
+				return diag.Errorf("moving pipeline '%s' to pipeline group '%s' errored with: %v", pipeline, cfg.Name, err)
+			}
+		}
+	}
+
+	baseCfg := gocd.PipelineGroup{
+		Name:          cfg.Name,
+		Pipelines:     getPipelines(getOldValue(d)(utils.TerraformResourcePipelines)),
+		Authorization: getPipelineGroupAuthorizationConfig(getOldValue(d)(utils.TerraformResourceAuthorization)),
+	}
+
+	authorizationUnset := isBlockUnset(d, utils.TerraformResourceAuthorization)
+	if d.HasChange(utils.TerraformResourcePipelines) || authorizationUnset {
+		// moving the pipelines changes the pipeline group, so the etag has to be fetched again.
+		response, err := defaultConfig.GetPipelineGroup(cfg.Name)
+		if err != nil {
+			return diag.Errorf("getting pipeline group '%s' errored with: %v", cfg.Name, err)
+		}
+
+		cfg.ETAG = response.ETAG
+
+		if authorizationUnset {
+			// authorization is managed by gocd_pipeline_group_permission resources, so the existing one is retained.
+			cfg.Authorization = response.Authorization
+			baseCfg.Authorization = response.Authorization
+		}
+	}
+
+	err := updateOnConflict(ctx, meta, "pipeline group", cfg.Name, baseCfg, cfg, cfg.ETAG,
+		func() (gocd.PipelineGroup, string, error) {
+			response, err := defaultConfig.GetPipelineGroup(cfg.Name)
+
+			return response, response.ETAG, err
+		},
+		func(pipelineGroup gocd.PipelineGroup, etag string) error {
+			pipelineGroup.ETAG = etag
+			_, err := defaultConfig.UpdatePipelineGroup(pipelineGroup)
+
+			return err
+		})
+	if err != nil {
 		return diag.Errorf("updating pipeline group '%s' errored with: %v", cfg.Name, err)
 	}
 
 	return resourcePipelineGroupRead(ctx, d, meta)
 }
 
-func resourcePipelineGroupDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourcePipelineGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if id := d.Id(); len(id) == 0 {
 		return diag.Errorf("resource with the ID '%s' not found", id)
@@ -146,6 +193,10 @@ func getPipelineGroupAuthorizationConfig(authConfig interface{}) gocd.PipelineGr
 	var flattenedView, flattenedAdmins, flattenedOperate map[string]interface{}
 
 	var authorisationConfig gocd.PipelineGroupAuthorizationConfig
+	if len(authConfig.(*schema.Set).List()) == 0 {
+		return authorisationConfig
+	}
+
 	flattenedAuthConfig := authConfig.(*schema.Set).List()[0].(map[string]interface{})
 
 	if len(flattenedAuthConfig[utils.TerraformResourceView].(*schema.Set).List()) > 0 {
@@ -177,3 +228,54 @@ func getPipelineGroupAuthorizationConfig(authConfig interface{}) gocd.PipelineGr
 
 	return authorisationConfig
 }
+
+// claimPipeline reports the pipeline being declared under two different groups in the same configuration, while planning.
+func claimPipeline(meta interface{}, pipeline, group string) error {
+	goCDClient, ok := meta.(client.GoCD)
+	if !ok {
+		return nil
+	}
+
+	return goCDClient.ClaimPipeline(pipeline, group)
+}
+
+func resourcePipelineGroupCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown(utils.TerraformResourceName) || !d.NewValueKnown(utils.TerraformResourcePipelines) {
+		return nil
+	}
+
+	group := utils.String(d.Get(utils.TerraformResourceName))
+	for _, pipeline := range utils.GetSlice(d.Get(utils.TerraformResourcePipelines).([]interface{})) {
+		if err := claimPipeline(meta, pipeline, group); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// movePipelineToGroup moves an existing pipeline to the specified group without recreating it, retaining its history.
+// The config of the pipeline is sent back as returned by GoCD with only its group changed, retried when the pipeline is modified in between.
+func movePipelineToGroup(ctx context.Context, meta interface{}, pipeline, group string) error {
+	goCDClient := meta.(client.GoCD)
+
+	goCDClient.InvalidatePipelineConfigs()
+
+	return patchOnConflict(ctx, meta, "pipeline", pipeline,
+		func() (client.PipelineConfigFields, error) {
+			return goCDClient.GetPipelineConfigFields(ctx, pipeline)
+		},
+		func(pipelineCfg *client.PipelineConfigFields) (bool, error) {
+			return pipelineCfg.Fields["group"] != group, nil
+		},
+		func(pipelineCfg client.PipelineConfigFields) error {
+			return goCDClient.MovePipelineToGroup(ctx, pipeline, group, pipelineCfg)
+		})
+}
+
+// isBlockUnset reports whether the nested block is not declared in the configuration of the resource.
+func isBlockUnset(d *schema.ResourceData, key string) bool {
+	block := d.GetRawConfig().GetAttr(key)
+
+	return block.IsNull() || (block.IsKnown() && block.LengthInt() == 0)
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+var pipelineGroupPermissions = []string{utils.TerraformResourceView, utils.TerraformResourceOperate, utils.TerraformResourceAdmins}
+
+func resourcePipelineGroupPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePipelineGroupPermissionCreate,
+		ReadContext:   resourcePipelineGroupPermissionRead,
+		DeleteContext: resourcePipelineGroupPermissionDelete,
+		Timeouts:      resourceTimeouts(false),
+		CustomizeDiff: checkPermission(client.PermissionPipelineGroup, utils.TerraformResourcePipelineGroup, false),
+		Schema: map[string]*schema.Schema{
+			"pipeline_group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the pipeline group on which the permission should be granted.",
+			},
+			"permission": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Computed:         false,
+				ForceNew:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(pipelineGroupPermissions, false)),
+				Description:      "The permission level to be granted, should be one of `view`, `operate` or `admins`.",
+			},
+			"user": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				ForceNew:     true,
+				ExactlyOneOf: []string{utils.TerraformResourceUser, utils.TerraformResourceRole},
+				Description:  "The name of the user to whom the permission should be granted. You MUST specify one of user or role.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     false,
+				ForceNew:     true,
+				ExactlyOneOf: []string{utils.TerraformResourceUser, utils.TerraformResourceRole},
+				Description:  "The name of the role to which the permission should be granted. You MUST specify one of user or role.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePipelineGroupPermissionImport,
+		},
+	}
+}
+
+func resourcePipelineGroupPermissionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	group := utils.String(d.Get(utils.TerraformResourcePipelineGroup))
+	permission := utils.String(d.Get(utils.TerraformResourcePermission))
+	memberType, member := getPipelineGroupPermissionMember(d)
+
+	err := patchPipelineGroup(ctx, meta, group, func(pipelineGroup *gocd.PipelineGroup) bool {
+		authConfig := getPipelineGroupPermission(&pipelineGroup.Authorization, permission)
+		members := getPipelineGroupPermissionMembers(authConfig, memberType)
+		if utils.Contains(*members, member) {
+			return false
+		}
+
+		*members = append(*members, member)
+
+		return true
+	})
+	if err != nil {
+		return diag.Errorf("granting '%s' permission on pipeline group '%s' to %s '%s' errored with: %v", permission, group, memberType, member, err)
+	}
+
+	d.SetId(strings.Join([]string{group, permission, memberType, member}, "/"))
+
+	return resourcePipelineGroupPermissionRead(ctx, d, meta)
+}
+
+func resourcePipelineGroupPermissionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	group := utils.String(d.Get(utils.TerraformResourcePipelineGroup))
+	permission := utils.String(d.Get(utils.TerraformResourcePermission))
+	memberType, member := getPipelineGroupPermissionMember(d)
+
+	response, err := defaultConfig.GetPipelineGroup(group)
+	if err != nil {
+		return diag.Errorf("getting pipeline group '%s' errored with: %v", group, err)
+	}
+
+	authConfig := getPipelineGroupPermission(&response.Authorization, permission)
+	if !utils.Contains(*getPipelineGroupPermissionMembers(authConfig, memberType), member) {
+		tflog.Warn(ctx, "permission on the pipeline group was revoked outside of terraform, removing it from state",
+			map[string]interface{}{memberType: member, "permission": permission, "pipeline_group": group})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourcePipelineGroupPermissionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	group := utils.String(d.Get(utils.TerraformResourcePipelineGroup))
+	permission := utils.String(d.Get(utils.TerraformResourcePermission))
+	memberType, member := getPipelineGroupPermissionMember(d)
+
+	err := patchPipelineGroup(ctx, meta, group, func(pipelineGroup *gocd.PipelineGroup) bool {
+		members := getPipelineGroupPermissionMembers(getPipelineGroupPermission(&pipelineGroup.Authorization, permission), memberType)
+
+		updatedMembers := make([]string, 0)
+		for _, existingMember := range *members {
+			if existingMember != member {
+				updatedMembers = append(updatedMembers, existingMember)
+			}
+		}
+
+		if len(updatedMembers) == len(*members) {
+			return false
+		}
+
+		*members = updatedMembers
+
+		return true
+	})
+	if err != nil {
+		return diag.Errorf("revoking '%s' permission on pipeline group '%s' from %s '%s' errored with: %v", permission, group, memberType, member, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourcePipelineGroupPermissionImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 4)
+	if len(idParts) != 4 || (idParts[2] != utils.TerraformResourceUser && idParts[2] != utils.TerraformResourceRole) {
+		return nil, fmt.Errorf("invalid ID '%s', it should be of format '<pipeline_group>/<permission>/<user|role>/<name>'", d.Id())
+	}
+
+	importedPermission := map[string]string{
+		utils.TerraformResourcePipelineGroup: idParts[0],
+		utils.TerraformResourcePermission:    idParts[1],
+		idParts[2]:                           idParts[3],
+	}
+
+	for key, value := range importedPermission {
+		if err := d.Set(key, value); err != nil {
+			return nil, fmt.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getPipelineGroupPermissionMember(d *schema.ResourceData) (string, string) {
+	if user := utils.String(d.Get(utils.TerraformResourceUser)); len(user) != 0 {
+		return utils.TerraformResourceUser, user
+	}
+
+	return utils.TerraformResourceRole, utils.String(d.Get(utils.TerraformResourceRole))
+}
+
+func getPipelineGroupPermission(authorization *gocd.PipelineGroupAuthorizationConfig, permission string) *gocd.AuthorizationConfig {
+	switch permission {
+	case utils.TerraformResourceOperate:
+		return &authorization.Operate
+	case utils.TerraformResourceAdmins:
+		return &authorization.Admins
+	default:
+		return &authorization.View
+	}
+}
+
+func getPipelineGroupPermissionMembers(authConfig *gocd.AuthorizationConfig, memberType string) *[]string {
+	if memberType == utils.TerraformResourceUser {
+		return &authConfig.Users
+	}
+
+	return &authConfig.Roles
+}
+
+// patchPipelineGroup updates the pipeline group with the changes made by patch on the latest pipeline group obtained from GoCD,
+// update is skipped when patch reports no changes and retried when the pipeline group is modified in between.
+func patchPipelineGroup(ctx context.Context, meta interface{}, name string, patch func(pipelineGroup *gocd.PipelineGroup) bool) error {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	return patchOnConflict(ctx, meta, "pipeline group", name,
+		func() (gocd.PipelineGroup, error) {
+			return defaultConfig.GetPipelineGroup(name)
+		},
+		func(pipelineGroup *gocd.PipelineGroup) (bool, error) {
+			return patch(pipelineGroup), nil
+		},
+		func(pipelineGroup gocd.PipelineGroup) error {
+			_, err := defaultConfig.UpdatePipelineGroup(pipelineGroup)
+
+			return err
+		})
+}
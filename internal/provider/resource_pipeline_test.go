@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestGetPipelineSecureVariables(t *testing.T) {
+	config := map[string]interface{}{
+		"name": "build",
+		"environment_variables": []interface{}{
+			map[string]interface{}{"name": "REGION", "value": "eu"},
+			map[string]interface{}{"name": "TOKEN", "value": "pipeline-secret", "secure": true},
+		},
+		"stages": []interface{}{
+			map[string]interface{}{
+				"name": "test",
+				"environment_variables": []interface{}{
+					map[string]interface{}{"name": "TOKEN", "value": "stage-secret", "secure": true},
+				},
+				"jobs": []interface{}{
+					map[string]interface{}{
+						"name": "unit",
+						"environment_variables": []interface{}{
+							map[string]interface{}{"name": "TOKEN", "value": "job-secret", "secure": true},
+							map[string]interface{}{"name": "KEY", "encrypted_value": "AES:one", "secure": true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{"name": "TOKEN", "encrypted_value": "", "value_checksum": getSecureValueChecksum("pipeline-secret")},
+		{"name": "test/TOKEN", "encrypted_value": "", "value_checksum": getSecureValueChecksum("stage-secret")},
+		{"name": "test/unit/TOKEN", "encrypted_value": "", "value_checksum": getSecureValueChecksum("job-secret")},
+	}
+
+	if d := cmp.Diff(expected, getPipelineSecureVariables(config)); d != "" {
+		t.Errorf("secure variables mismatch (-expected +actual):\n%s", d)
+	}
+
+	jobEnvVar := config["stages"].([]interface{})[0].(map[string]interface{})["jobs"].([]interface{})[0].(map[string]interface{})["environment_variables"].([]interface{})[0]
+	if name := jobEnvVar.(map[string]interface{})["name"]; name != "TOKEN" {
+		t.Errorf("pipeline config sent to GoCD was modified, name of the job variable is '%v'", name)
+	}
+}
+
+func TestFlattenPipelineSecureVariables(t *testing.T) {
+	checksum := getSecureValueChecksum("secret")
+
+	config := map[string]interface{}{
+		"name": "build",
+		"stages": []interface{}{
+			map[string]interface{}{
+				"name": "test",
+				"jobs": []interface{}{
+					map[string]interface{}{
+						"name": "unit",
+						"environment_variables": []interface{}{
+							map[string]interface{}{"name": "TOKEN", "encrypted_value": "AES:two", "secure": true},
+							map[string]interface{}{"name": "REGION", "value": "eu", "secure": false},
+						},
+					},
+				},
+			},
+		},
+		"environment_variables": []interface{}{
+			map[string]interface{}{"name": "TOKEN", "encrypted_value": "AES:one", "secure": true},
+		},
+	}
+
+	current := []interface{}{
+		map[string]interface{}{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum},
+		map[string]interface{}{"name": "test/unit/TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum},
+	}
+
+	expected := []map[string]interface{}{
+		{"name": "TOKEN", "encrypted_value": "AES:one", "value_checksum": checksum},
+		{"name": "test/unit/TOKEN", "encrypted_value": "AES:two", "value_checksum": ""},
+	}
+
+	if d := cmp.Diff(expected, flattenPipelineSecureVariables(context.Background(), config, current)); d != "" {
+		t.Errorf("secure variables mismatch (-expected +actual):\n%s", d)
+	}
+}
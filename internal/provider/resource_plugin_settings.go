@@ -17,11 +17,12 @@ package provider
 
 import (
 	"context"
-	"log"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -31,6 +32,8 @@ func resourcePluginsSetting() *schema.Resource {
 		ReadContext:   resourcePluginsSettingsRead,
 		DeleteContext: resourcePluginsSettingsDelete,
 		UpdateContext: resourcePluginsSettingsUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionPluginSettings, utils.TerraformResourcePluginID, true),
 		Schema: map[string]*schema.Schema{
 			"plugin_id": {
 				Type:        schema.TypeString,
@@ -81,7 +84,7 @@ func resourcePluginsSetting() *schema.Resource {
 }
 
 func resourcePluginsSettingsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
@@ -109,8 +112,8 @@ func resourcePluginsSettingsCreate(ctx context.Context, d *schema.ResourceData,
 	return resourcePluginsSettingsRead(ctx, d, meta)
 }
 
-func resourcePluginsSettingsRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourcePluginsSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	response, err := defaultConfig.GetPluginSettings(utils.String(d.Get(utils.TerraformResourcePluginID)))
 	if err != nil {
@@ -125,10 +128,10 @@ func resourcePluginsSettingsRead(_ context.Context, d *schema.ResourceData, meta
 }
 
 func resourcePluginsSettingsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.HasChange(utils.TerraformResourcePluginConfiguration) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
@@ -136,10 +139,12 @@ func resourcePluginsSettingsUpdate(ctx context.Context, d *schema.ResourceData,
 	pluginSettings := gocd.PluginSettings{
 		ID:            utils.String(d.Get(utils.TerraformResourcePluginID)),
 		Configuration: getPluginConfigurationPTR(d.Get(utils.TerraformResourcePluginConfiguration)),
-		ETAG:          utils.String(d.Get(utils.TerraformResourceEtag)),
 	}
 
-	_, err := defaultConfig.UpdatePluginSettings(pluginSettings)
+	baseSettings := pluginSettings
+	baseSettings.Configuration = getPluginConfigurationPTR(getOldValue(d)(utils.TerraformResourcePluginConfiguration))
+
+	err := updatePluginSettingsOnConflict(ctx, meta, defaultConfig, baseSettings, pluginSettings, utils.String(d.Get(utils.TerraformResourceEtag)))
 	if err != nil {
 		return diag.Errorf("updating plugin configuration errored with: %v", err)
 	}
@@ -147,8 +152,8 @@ func resourcePluginsSettingsUpdate(ctx context.Context, d *schema.ResourceData,
 	return resourcePluginsSettingsRead(ctx, d, meta)
 }
 
-func resourcePluginsSettingsDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourcePluginsSettingsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if id := d.Id(); len(id) == 0 {
 		return diag.Errorf("resource with the ID %s not found", id)
@@ -157,10 +162,12 @@ func resourcePluginsSettingsDelete(_ context.Context, d *schema.ResourceData, me
 	pluginSettings := gocd.PluginSettings{
 		ID:            utils.String(d.Get(utils.TerraformResourcePluginID)),
 		Configuration: []*gocd.PluginConfiguration{},
-		ETAG:          utils.String(d.Get(utils.TerraformResourceEtag)),
 	}
 
-	_, err := defaultConfig.UpdatePluginSettings(pluginSettings)
+	baseSettings := pluginSettings
+	baseSettings.Configuration = getPluginConfigurationPTR(d.Get(utils.TerraformResourcePluginConfiguration))
+
+	err := updatePluginSettingsOnConflict(ctx, meta, defaultConfig, baseSettings, pluginSettings, utils.String(d.Get(utils.TerraformResourceEtag)))
 	if err != nil {
 		return diag.Errorf("updating plugin configuration errored with: %v", err)
 	}
@@ -170,6 +177,21 @@ func resourcePluginsSettingsDelete(_ context.Context, d *schema.ResourceData, me
 	return nil
 }
 
+func updatePluginSettingsOnConflict(ctx context.Context, meta interface{}, defaultConfig gocd.GoCd, base, desired gocd.PluginSettings, etag string) error {
+	return updateOnConflict(ctx, meta, "plugin settings", desired.ID, base, desired, etag,
+		func() (gocd.PluginSettings, string, error) {
+			response, err := defaultConfig.GetPluginSettings(desired.ID)
+
+			return response, response.ETAG, err
+		},
+		func(settings gocd.PluginSettings, etag string) error {
+			settings.ETAG = etag
+			_, err := defaultConfig.UpdatePluginSettings(settings)
+
+			return err
+		})
+}
+
 func getPluginConfigurationPTR(configs interface{}) []*gocd.PluginConfiguration {
 	pluginsConfigurations := make([]*gocd.PluginConfiguration, 0)
 	for i, config := range configs.(*schema.Set).List() {
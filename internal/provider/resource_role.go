@@ -3,12 +3,13 @@ package provider
 import (
 	"context"
 	"fmt"
-	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -22,6 +23,8 @@ func resourceRole() *schema.Resource {
 		ReadContext:   resourceRoleRead,
 		DeleteContext: resourceRoleDelete,
 		UpdateContext: resourceRoleUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionRole, utils.TerraformResourceName, true),
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -49,15 +52,16 @@ func resourceRole() *schema.Resource {
 				Required:    true,
 				Computed:    false,
 				ForceNew:    false,
+				ConfigMode:  schema.SchemaConfigModeAttr,
 				Description: "Policy is fine-grained permissions attached to the users belonging to the current role.",
-				Elem:        &schema.Schema{Type: schema.TypeMap},
+				Elem:        policySchema(utils.TerraformResourcePermission, rolePolicyActions, rolePolicyTypes),
 			},
 			"users": {
 				Type:        schema.TypeList,
 				Computed:    false,
 				Optional:    true,
 				ForceNew:    false,
-				Description: "The list of users belongs to the role.",
+				Description: "The list of users belongs to the role. When not set, users of the role are left untouched so that they can be managed by `gocd_role_membership`.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"auth_config_id": {
@@ -79,12 +83,18 @@ func resourceRole() *schema.Resource {
 }
 
 func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !d.IsNewResource() {
 		return nil
 	}
 
+	if _, ok := d.GetOk(utils.TerraformResourcePolicy); ok {
+		if diags := checkFeature(ctx, meta, client.FeatureRolePolicies); diags.HasError() {
+			return diags
+		}
+	}
+
 	id := d.Id()
 
 	if len(id) == 0 {
@@ -119,7 +129,7 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.Errorf("creating role '%s' of type '%s' errored with %v", id, roleCfg.Type, err)
 	}
 
-	if err = updateAdmin(defaultConfig, d); err != nil {
+	if err = updateAdmin(ctx, defaultConfig, d); err != nil {
 		return diag.Errorf("%v", err)
 	}
 
@@ -128,8 +138,8 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 	return resourceRoleRead(ctx, d, meta)
 }
 
-func resourceRoleRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	name := d.Id()
 	response, err := defaultConfig.GetRole(name)
@@ -145,55 +155,72 @@ func resourceRoleRead(_ context.Context, d *schema.ResourceData, meta interface{
 }
 
 func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	if _, ok := d.GetOk(utils.TerraformResourcePolicy); ok {
+		if diags := checkFeature(ctx, meta, client.FeatureRolePolicies); diags.HasError() {
+			return diags
+		}
+	}
 
 	if !d.HasChange(utils.TerraformResourceProperties) &&
 		!d.HasChange(utils.TerraformResourcePolicy) &&
 		!d.HasChange(utils.TerraformResourceUsers) &&
 		!d.HasChange(utils.TerraformResourceSystemAdmin) {
-		log.Printf("nothing to update so skipping")
+		tflog.Debug(ctx, "nothing to update so skipping")
 
 		return nil
 	}
 
-	roleCfg := gocd.Role{
-		Name: utils.String(d.Get(utils.TerraformResourceName)),
-		Type: utils.String(d.Get(utils.TerraformResourceType)),
-		ETAG: utils.String(d.Get(utils.TerraformResourceEtag)),
+	roleCfg, err := getRoleConfig(d, d.Get)
+	if err != nil {
+		return diag.Errorf("%v", err)
 	}
 
-	policy, err := flattenMapSlice(d.Get(utils.TerraformResourcePolicy))
+	baseRoleCfg, err := getRoleConfig(d, getOldValue(d))
 	if err != nil {
-		return diag.Errorf("flattening policy errored with %v", err)
+		return diag.Errorf("%v", err)
 	}
 
-	roleCfg.Policy = policy
+	etag := utils.String(d.Get(utils.TerraformResourceEtag))
 
-	roleType := strings.ToLower(roleCfg.Type)
-	switch roleType {
-	case "plugin":
-		roleCfg.Attributes.AuthConfigID = utils.String(d.Get(utils.TerraformResourceAuthConfigID))
-		roleCfg.Attributes.Properties = getPluginConfiguration(d.Get(utils.TerraformResourceProperties))
-	case "gocd":
-		roleAttr := gocd.RoleAttribute{Users: utils.GetSlice(d.Get(utils.TerraformResourceUsers).([]interface{}))}
-		roleCfg.Attributes = roleAttr
-	default:
-		return diag.Errorf("unknown role type '%s'", roleType)
+	if strings.EqualFold(roleCfg.Type, "gocd") && d.GetRawConfig().GetAttr(utils.TerraformResourceUsers).IsNull() {
+		// users are managed by gocd_role_membership resources, so the existing users of the role are retained.
+		existingRole, err := defaultConfig.GetRole(roleCfg.Name)
+		if err != nil {
+			return diag.Errorf("fetching role %s errored with: %v", roleCfg.Name, err)
+		}
+
+		roleCfg.Attributes.Users = existingRole.Attributes.Users
+		baseRoleCfg.Attributes.Users = existingRole.Attributes.Users
+		etag = existingRole.ETAG
 	}
 
-	if _, err = defaultConfig.UpdateRole(roleCfg); err != nil {
+	err = updateOnConflict(ctx, meta, "role", roleCfg.Name, baseRoleCfg, roleCfg, etag,
+		func() (gocd.Role, string, error) {
+			role, err := defaultConfig.GetRole(roleCfg.Name)
+
+			return role, role.ETAG, err
+		},
+		func(role gocd.Role, etag string) error {
+			role.ETAG = etag
+			_, err := defaultConfig.UpdateRole(role)
+
+			return err
+		})
+	if err != nil {
 		return diag.Errorf("updating role '%s' of type '%s' errored with %v", roleCfg.Name, roleCfg.Type, err)
 	}
 
-	if err = updateAdmin(defaultConfig, d); err != nil {
+	if err = updateAdmin(ctx, defaultConfig, d); err != nil {
 		return diag.Errorf("%v", err)
 	}
 
 	return resourceRoleRead(ctx, d, meta)
 }
 
-func resourceRoleDelete(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	id := d.Id()
 	if len(d.Id()) == 0 {
@@ -212,8 +239,54 @@ func resourceRoleDelete(_ context.Context, d *schema.ResourceData, meta interfac
 	return nil
 }
 
+// getRoleConfig builds the role from the values returned by get, which could either be the ones being applied or the ones known before.
+func getRoleConfig(d *schema.ResourceData, get func(key string) interface{}) (gocd.Role, error) {
+	roleCfg := gocd.Role{
+		Name: utils.String(d.Get(utils.TerraformResourceName)),
+		Type: utils.String(d.Get(utils.TerraformResourceType)),
+	}
+
+	policy, err := flattenMapSlice(get(utils.TerraformResourcePolicy))
+	if err != nil {
+		return roleCfg, fmt.Errorf("flattening policy errored with %w", err)
+	}
+
+	roleCfg.Policy = policy
+
+	roleType := strings.ToLower(roleCfg.Type)
+	switch roleType {
+	case "plugin":
+		roleCfg.Attributes.AuthConfigID = utils.String(get(utils.TerraformResourceAuthConfigID))
+		roleCfg.Attributes.Properties = getPluginConfiguration(get(utils.TerraformResourceProperties))
+	case "gocd":
+		roleCfg.Attributes = gocd.RoleAttribute{Users: utils.GetSlice(get(utils.TerraformResourceUsers).([]interface{}))}
+	default:
+		return roleCfg, fmt.Errorf("unknown role type '%s'", roleType)
+	}
+
+	return roleCfg, nil
+}
+
+// patchRole updates the role with the changes made by patch on the latest role config obtained from GoCD,
+// update is skipped when patch reports no changes and retried when the role is modified in between.
+// GoCD patches only the users of the roles, so the properties of the roles of type plugin are updated with the whole role.
+func patchRole(ctx context.Context, meta interface{}, name string, patch func(role *gocd.Role) (bool, error)) error {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	return patchOnConflict(ctx, meta, "role", name,
+		func() (gocd.Role, error) {
+			return defaultConfig.GetRole(name)
+		},
+		patch,
+		func(role gocd.Role) error {
+			_, err := defaultConfig.UpdateRole(role)
+
+			return err
+		})
+}
+
 // Ensures the role is added as a system admin in GoCD.
-func updateAdmin(defaultConfig gocd.GoCd, d *schema.ResourceData) error {
+func updateAdmin(ctx context.Context, defaultConfig gocd.GoCd, d *schema.ResourceData) error {
 	resourceName := utils.String(d.Get(utils.TerraformResourceName))
 	isAdmin := utils.Bool(d.Get(utils.TerraformResourceSystemAdmin))
 
@@ -230,10 +303,10 @@ func updateAdmin(defaultConfig gocd.GoCd, d *schema.ResourceData) error {
 
 	addNRemove := gocd.AddRemoves{}
 	if isAdmin {
-		log.Printf("Adding role '%s' to system admins", resourceName)
+		tflog.Info(ctx, "adding role to system admins", map[string]interface{}{"role": resourceName})
 		addNRemove.Add = []string{resourceName}
 	} else {
-		log.Printf("Removing role '%s' from system admins", resourceName)
+		tflog.Info(ctx, "removing role from system admins", map[string]interface{}{"role": resourceName})
 		addNRemove.Remove = []string{resourceName}
 	}
 
@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	defaultRoleBindingProperty  = "GroupIdentifiers"
+	defaultRoleBindingSeparator = ";"
+)
+
+func resourceRoleBinding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleBindingCreate,
+		ReadContext:   resourceRoleBindingRead,
+		DeleteContext: resourceRoleBindingDelete,
+		Timeouts:      resourceTimeouts(false),
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the role of type plugin to which the group should be bound.",
+			},
+			"group": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The external group (ex: LDAP group DN or GitHub organization/team) that should be mapped to the role.",
+			},
+			"property": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: false,
+				ForceNew: true,
+				Default:  defaultRoleBindingProperty,
+				Description: "The role property of the authorization plugin that holds the groups, " +
+					"ex: `GroupIdentifiers` for LDAP or `Organizations`/`Teams` for GitHub. Defaults to `GroupIdentifiers`.",
+			},
+			"separator": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Default:     defaultRoleBindingSeparator,
+				Description: "The separator used by the authorization plugin between the groups of the property. Defaults to `;`.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRoleBindingImport,
+		},
+	}
+}
+
+func resourceRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	roleName := utils.String(d.Get(utils.TerraformResourceRole))
+	group := utils.String(d.Get(utils.TerraformResourceGroup))
+	property := utils.String(d.Get(utils.TerraformResourceProperty))
+	separator := utils.String(d.Get(utils.TerraformResourceSeparator))
+
+	err := patchRole(ctx, meta, roleName, func(role *gocd.Role) (bool, error) {
+		if err := validateRoleType(role, "plugin"); err != nil {
+			return false, err
+		}
+
+		groups := getRoleBindingGroups(role, property, separator)
+		if utils.Contains(groups, group) {
+			return false, nil
+		}
+
+		setRoleBindingGroups(role, property, separator, append(groups, group))
+
+		return true, nil
+	})
+	if err != nil {
+		return diag.Errorf("binding group '%s' to role '%s' errored with: %v", group, roleName, err)
+	}
+
+	d.SetId(utils.GetMembershipID(roleName, group))
+
+	return resourceRoleBindingRead(ctx, d, meta)
+}
+
+func resourceRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	roleName := utils.String(d.Get(utils.TerraformResourceRole))
+	group := utils.String(d.Get(utils.TerraformResourceGroup))
+
+	response, err := defaultConfig.GetRole(roleName)
+	if err != nil {
+		return diag.Errorf("fetching role %s errored with: %v", roleName, err)
+	}
+
+	groups := getRoleBindingGroups(&response, utils.String(d.Get(utils.TerraformResourceProperty)), utils.String(d.Get(utils.TerraformResourceSeparator)))
+	if !utils.Contains(groups, group) {
+		tflog.Warn(ctx, "group is no longer bound to the role, removing it from state", map[string]interface{}{"group": group, "role": roleName})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	roleName := utils.String(d.Get(utils.TerraformResourceRole))
+	group := utils.String(d.Get(utils.TerraformResourceGroup))
+	property := utils.String(d.Get(utils.TerraformResourceProperty))
+	separator := utils.String(d.Get(utils.TerraformResourceSeparator))
+
+	err := patchRole(ctx, meta, roleName, func(role *gocd.Role) (bool, error) {
+		existingGroups := getRoleBindingGroups(role, property, separator)
+
+		groups := make([]string, 0)
+		for _, existingGroup := range existingGroups {
+			if existingGroup != group {
+				groups = append(groups, existingGroup)
+			}
+		}
+
+		if len(groups) == len(existingGroups) {
+			return false, nil
+		}
+
+		setRoleBindingGroups(role, property, separator, groups)
+
+		return true, nil
+	})
+	if err != nil {
+		return diag.Errorf("unbinding group '%s' from role '%s' errored with: %v", group, roleName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// resourceRoleBindingImport imports the binding by the ID of format `<role>/<group>/<property>[/<separator>]`,
+// the separator defaults to `;` when not set.
+func resourceRoleBindingImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	roleName, rest, _ := strings.Cut(d.Id(), "/")
+	group, rest, _ := strings.Cut(rest, "/")
+	property, separator, hasSeparator := strings.Cut(rest, "/")
+
+	if len(roleName) == 0 || len(group) == 0 || len(property) == 0 || (hasSeparator && len(separator) == 0) {
+		return nil, fmt.Errorf("invalid ID '%s', it should be of format '<role>/<group>/<property>[/<separator>]'", d.Id())
+	}
+
+	if !hasSeparator {
+		separator = defaultRoleBindingSeparator
+	}
+
+	importedBinding := map[string]string{
+		utils.TerraformResourceRole:      roleName,
+		utils.TerraformResourceGroup:     group,
+		utils.TerraformResourceProperty:  property,
+		utils.TerraformResourceSeparator: separator,
+	}
+
+	for key, value := range importedBinding {
+		if err := d.Set(key, value); err != nil {
+			return nil, fmt.Errorf(settingAttrErrorTmp, key, err)
+		}
+	}
+
+	d.SetId(utils.GetMembershipID(roleName, group))
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func getRoleBindingGroups(role *gocd.Role, property, separator string) []string {
+	groups := make([]string, 0)
+	for _, roleProperty := range role.Attributes.Properties {
+		if roleProperty.Key != property {
+			continue
+		}
+
+		for _, group := range strings.Split(roleProperty.Value, separator) {
+			if group = strings.TrimSpace(group); len(group) != 0 {
+				groups = append(groups, group)
+			}
+		}
+	}
+
+	return groups
+}
+
+// setRoleBindingGroups sets the groups on the role property, rest of the properties of the role are left untouched.
+func setRoleBindingGroups(role *gocd.Role, property, separator string, groups []string) {
+	value := strings.Join(groups, separator)
+	for index, roleProperty := range role.Attributes.Properties {
+		if roleProperty.Key == property {
+			role.Attributes.Properties[index].Value = value
+
+			return
+		}
+	}
+
+	role.Attributes.Properties = append(role.Attributes.Properties, gocd.PluginConfiguration{Key: property, Value: value})
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceRoleBindingImport(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		expected  map[string]string
+		expectErr bool
+	}{
+		{
+			name:     "separator defaults when not set",
+			id:       "sample-ldap/CN=platform,OU=Groups,DC=TESTCOM,DC=COM/GroupIdentifiers",
+			expected: map[string]string{"role": "sample-ldap", "group": "CN=platform,OU=Groups,DC=TESTCOM,DC=COM", "property": "GroupIdentifiers", "separator": ";"},
+		},
+		{
+			name:     "separator set",
+			id:       "sample-github/platform/Teams/,",
+			expected: map[string]string{"role": "sample-github", "group": "platform", "property": "Teams", "separator": ","},
+		},
+		{name: "property not set", id: "sample-ldap/platform", expectErr: true},
+		{name: "empty separator", id: "sample-ldap/platform/GroupIdentifiers/", expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := resourceRoleBinding().Data(&terraform.InstanceState{ID: test.id})
+
+			_, err := resourceRoleBindingImport(context.Background(), d, nil)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+
+			if test.expectErr {
+				return
+			}
+
+			for key, value := range test.expected {
+				if actual := d.Get(key).(string); actual != value {
+					t.Errorf("expected %s to be '%s', got '%s'", key, value, actual)
+				}
+			}
+
+			if d.Id() != test.expected["role"]+"/"+test.expected["group"] {
+				t.Errorf("unexpected ID '%s'", d.Id())
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+func resourceRoleMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoleMembershipCreate,
+		ReadContext:   resourceRoleMembershipRead,
+		DeleteContext: resourceRoleMembershipDelete,
+		Timeouts:      resourceTimeouts(false),
+		Schema: map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the role of type gocd to which the user should be added.",
+			},
+			"user": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Computed:    false,
+				ForceNew:    true,
+				Description: "The name of the user that should be added to the role.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRoleMembershipImport,
+		},
+	}
+}
+
+func resourceRoleMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	roleName := utils.String(d.Get(utils.TerraformResourceRole))
+	user := utils.String(d.Get(utils.TerraformResourceUser))
+
+	err := patchRoleUsers(ctx, meta, roleName, func(role gocd.Role) (client.AddRemove, error) {
+		if err := validateRoleType(&role, "gocd"); err != nil {
+			return client.AddRemove{}, err
+		}
+
+		if utils.Contains(role.Attributes.Users, user) {
+			return client.AddRemove{}, nil
+		}
+
+		return client.AddRemove{Add: []string{user}}, nil
+	})
+	if err != nil {
+		return diag.Errorf("adding user '%s' to role '%s' errored with: %v", user, roleName, err)
+	}
+
+	d.SetId(utils.GetMembershipID(roleName, user))
+
+	return resourceRoleMembershipRead(ctx, d, meta)
+}
+
+func resourceRoleMembershipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	roleName := utils.String(d.Get(utils.TerraformResourceRole))
+	user := utils.String(d.Get(utils.TerraformResourceUser))
+
+	response, err := defaultConfig.GetRole(roleName)
+	if err != nil {
+		return diag.Errorf("fetching role %s errored with: %v", roleName, err)
+	}
+
+	if !utils.Contains(response.Attributes.Users, user) {
+		tflog.Warn(ctx, "user is no longer part of the role, removing it from state", map[string]interface{}{"user": user, "role": roleName})
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceRoleMembershipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	roleName := utils.String(d.Get(utils.TerraformResourceRole))
+	user := utils.String(d.Get(utils.TerraformResourceUser))
+
+	err := patchRoleUsers(ctx, meta, roleName, func(role gocd.Role) (client.AddRemove, error) {
+		if !utils.Contains(role.Attributes.Users, user) {
+			return client.AddRemove{}, nil
+		}
+
+		return client.AddRemove{Remove: []string{user}}, nil
+	})
+	if err != nil {
+		return diag.Errorf("removing user '%s' from role '%s' errored with: %v", user, roleName, err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func resourceRoleMembershipImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
+	roleName, user, err := utils.ParseMembershipID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.Set(utils.TerraformResourceRole, roleName); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceRole, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceUser, user); err != nil {
+		return nil, fmt.Errorf(settingAttrErrorTmp, utils.TerraformResourceUser, err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// patchRoleUsers adds and removes the users returned by changes for the latest role config obtained from GoCD in a single PATCH call,
+// the call is skipped when there are no users to be added or removed.
+func patchRoleUsers(ctx context.Context, meta interface{}, name string, changes func(role gocd.Role) (client.AddRemove, error)) error {
+	role, err := meta.(client.GoCD).WithContext(ctx).GetRole(name)
+	if err != nil {
+		return fmt.Errorf("fetching role '%s' errored with: %w", name, err)
+	}
+
+	users, err := changes(role)
+	if err != nil {
+		return err
+	}
+
+	if len(users.Add) == 0 && len(users.Remove) == 0 {
+		tflog.Debug(ctx, "role is already up to date so skipping", map[string]interface{}{"role": name})
+
+		return nil
+	}
+
+	return meta.(client.GoCD).PatchRoleUsers(ctx, name, users)
+}
+
+func validateRoleType(role *gocd.Role, roleType string) error {
+	if !strings.EqualFold(role.Type, roleType) {
+		return fmt.Errorf("role '%s' is of type '%s', only the roles of type '%s' are supported", role.Name, role.Type, roleType)
+	}
+
+	return nil
+}
@@ -2,12 +2,12 @@ package provider
 
 import (
 	"context"
-	"log"
 
-	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
@@ -17,6 +17,8 @@ func resourceSecretConfig() *schema.Resource {
 		ReadContext:   resourceSecretConfigRead,
 		DeleteContext: resourceSecretConfigDelete,
 		UpdateContext: resourceSecretConfigUpdate,
+		Timeouts:      resourceTimeouts(true),
+		CustomizeDiff: checkPermission(client.PermissionSecretConfig, utils.TerraformResourceProfileID, true),
 		Schema: map[string]*schema.Schema{
 			"profile_id": {
 				Type:        schema.TypeString,
@@ -53,13 +55,8 @@ func resourceSecretConfig() *schema.Resource {
 				Description: "The list of rules, which allows restricting the usage of the secret config. " +
 					"Referring to the secret config from other parts of configuration is denied by default, " +
 					"an explicit rule should be added to allow a specific resource to refer the secret config.",
-				Elem: &schema.Schema{
-					Type:        schema.TypeMap,
-					Required:    false,
-					Computed:    false,
-					ForceNew:    false,
-					Description: "Rule, which allows restricting the entities that the secret config can refer to.",
-				},
+				ConfigMode: schema.SchemaConfigModeAttr,
+				Elem:       policySchema(utils.TerraformResourceDirective, ruleActions, secretConfigRuleTypes),
 			},
 			"etag": {
 				Type:        schema.TypeString,
@@ -72,12 +69,16 @@ func resourceSecretConfig() *schema.Resource {
 }
 
 func resourceSecretConfigCreate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if !data.IsNewResource() {
 		return nil
 	}
 
+	if diags := checkFeature(ctx, meta, client.FeatureSecretConfigs); diags.HasError() {
+		return diags
+	}
+
 	id := data.Id()
 
 	if len(id) == 0 {
@@ -108,16 +109,10 @@ func resourceSecretConfigCreate(ctx context.Context, data *schema.ResourceData,
 }
 
 func resourceSecretConfigUpdate(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if data.HasChange(utils.TerraformResourceProperties) ||
 		data.HasChange(utils.TerraformResourceRules) {
-		oldCfg, newCfg := data.GetChange(utils.TerraformResourceProperties)
-
-		if cmp.Equal(oldCfg, newCfg) {
-			return nil
-		}
-
 		rules, err := flattenMapSlice(data.Get(utils.TerraformResourceRules))
 		if err != nil {
 			return diag.Errorf("reading '%s' errored with %v", utils.TerraformResourceRules, err)
@@ -136,7 +131,27 @@ func resourceSecretConfigUpdate(ctx context.Context, data *schema.ResourceData,
 			Rules:       rules,
 		}
 
-		_, err = defaultConfig.UpdateSecretConfig(cfg)
+		baseRules, err := flattenMapSlice(getOldValue(data)(utils.TerraformResourceRules))
+		if err != nil {
+			return diag.Errorf("reading '%s' errored with %v", utils.TerraformResourceRules, err)
+		}
+
+		baseCfg := cfg
+		baseCfg.Properties = getPluginConfiguration(getOldValue(data)(utils.TerraformResourceProperties))
+		baseCfg.Rules = baseRules
+
+		err = updateOnConflict(ctx, meta, "secret config", cfg.ID, baseCfg, cfg, utils.String(data.Get(utils.TerraformResourceEtag)),
+			func() (gocd.CommonConfig, string, error) {
+				response, err := defaultConfig.GetSecretConfig(cfg.ID)
+
+				return response, response.ETAG, err
+			},
+			func(secretConfig gocd.CommonConfig, etag string) error {
+				secretConfig.ETAG = etag
+				_, err := defaultConfig.UpdateSecretConfig(secretConfig)
+
+				return err
+			})
 		if err != nil {
 			return diag.Errorf("updating secret config %s errored with: %v", cfg.ID, err)
 		}
@@ -144,13 +159,13 @@ func resourceSecretConfigUpdate(ctx context.Context, data *schema.ResourceData,
 		return resourceSecretConfigRead(ctx, data, meta)
 	}
 
-	log.Printf("nothing to update so skipping")
+	tflog.Debug(ctx, "nothing to update so skipping")
 
 	return nil
 }
 
-func resourceSecretConfigRead(_ context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceSecretConfigRead(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	profileID := utils.String(data.Get(utils.TerraformResourceProfileID))
 	response, err := defaultConfig.GetSecretConfig(profileID)
@@ -165,8 +180,8 @@ func resourceSecretConfigRead(_ context.Context, data *schema.ResourceData, meta
 	return nil
 }
 
-func resourceSecretConfigDelete(_ context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	defaultConfig := meta.(gocd.GoCd)
+func resourceSecretConfigDelete(ctx context.Context, data *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
 
 	if id := data.Id(); len(id) == 0 {
 		return diag.Errorf("resource with the ID '%s' not found", id)
@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/client"
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	defaultServerReadyMinInterval = "2s"
+	defaultServerReadyMaxInterval = "30s"
+	serverHealthLevelError        = "ERROR"
+)
+
+func resourceServerReady() *schema.Resource {
+	serverReady := serverReadySchema()
+	serverReady["triggers"] = &schema.Schema{
+		Type:        schema.TypeMap,
+		Optional:    true,
+		Computed:    false,
+		ForceNew:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: "Arbitrary values, changing any of them waits for GoCD server to be ready again (ex: the revision of the helm release of GoCD).",
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceServerReadyCreate,
+		ReadContext:   resourceServerReadyRead,
+		DeleteContext: resourceServerReadyDelete,
+		Timeouts:      resourceTimeouts(false),
+		Schema:        serverReady,
+	}
+}
+
+func resourceServerReadyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.IsNewResource() {
+		return nil
+	}
+
+	if diags := setServerReady(ctx, d, meta, d.Timeout(schema.TimeoutCreate)); diags.HasError() {
+		return diags
+	}
+
+	id, err := utils.GetRandomID()
+	if err != nil {
+		return diag.Errorf("errored while fetching randomID %v", err)
+	}
+
+	d.SetId(id)
+
+	return nil
+}
+
+func resourceServerReadyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	defaultConfig := meta.(client.GoCD).WithContext(ctx)
+
+	// the wait happens only on create, read just refreshes the health messages when GoCD server is reachable.
+	messages, err := defaultConfig.GetServerHealthMessages()
+	if err != nil {
+		tflog.Warn(ctx, "getting health messages of GoCD server errored, retaining the ones from state", map[string]interface{}{"error": err.Error()})
+
+		return nil
+	}
+
+	if err = d.Set(utils.TerraformResourceMessages, flattenServerHealthMessages(messages)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceMessages, err)
+	}
+
+	return nil
+}
+
+func resourceServerReadyDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	if id := d.Id(); len(id) == 0 {
+		return diag.Errorf("resource with the ID '%s' not found", id)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// setServerReady waits for GoCD server to be ready and sets its health and health messages.
+func setServerReady(ctx context.Context, d *schema.ResourceData, meta interface{}, timeout time.Duration) diag.Diagnostics {
+	minInterval, err := time.ParseDuration(utils.String(d.Get(utils.TerraformResourceMinInterval)))
+	if err != nil {
+		return diag.Errorf("parsing '%s' errored with: %v", utils.TerraformResourceMinInterval, err)
+	}
+
+	maxInterval, err := time.ParseDuration(utils.String(d.Get(utils.TerraformResourceMaxInterval)))
+	if err != nil {
+		return diag.Errorf("parsing '%s' errored with: %v", utils.TerraformResourceMaxInterval, err)
+	}
+
+	health, messages, err := waitForServerReady(ctx, meta.(client.GoCD), timeout, minInterval, maxInterval)
+	if err != nil {
+		return diag.Errorf("%v", err)
+	}
+
+	if err = d.Set(utils.TerraformResourceHealth, health); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceHealth, err)
+	}
+
+	if err = d.Set(utils.TerraformResourceMessages, flattenServerHealthMessages(messages)); err != nil {
+		return diag.Errorf(settingAttrErrorTmp, utils.TerraformResourceMessages, err)
+	}
+
+	return nil
+}
+
+// waitForServerReady polls GoCD server with backoff until its health check passes and it has no health messages of level error,
+// or the timeout is hit. The interval between the polls starts at minInterval and is doubled on every poll up to maxInterval.
+func waitForServerReady(ctx context.Context, goCDClient client.GoCD, timeout, minInterval, maxInterval time.Duration,
+) (string, []gocd.ServerHealth, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	defaultConfig := goCDClient.WithContext(ctx)
+	interval := minInterval
+
+	for attempt := 1; ; attempt++ {
+		health, messages, err := getServerReadiness(defaultConfig)
+		if err == nil {
+			return health, messages, nil
+		}
+
+		tflog.Info(ctx, "GoCD server is not ready yet, retrying", map[string]interface{}{
+			"attempt":  attempt,
+			"interval": interval.String(),
+			"reason":   err.Error(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return "", nil, fmt.Errorf("GoCD server was not ready within %s, last check errored with: %w", timeout, err)
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// getServerReadiness returns an error when either GoCD server is not reachable or it has health messages of level error.
+func getServerReadiness(defaultConfig gocd.GoCd) (string, []gocd.ServerHealth, error) {
+	health, err := defaultConfig.GetServerHealth()
+	// health check responding with content that cannot be decoded still means GoCD server is up, same as the check done by the provider.
+	if err != nil && !errors.Is(err, goErr.MarshalError{}) {
+		return "", nil, fmt.Errorf("health check of GoCD server errored with: %w", err)
+	}
+
+	messages, err := defaultConfig.GetServerHealthMessages()
+	if err != nil {
+		return "", nil, fmt.Errorf("getting health messages of GoCD server errored with: %w", err)
+	}
+
+	errorMessages := make([]string, 0)
+	for _, message := range messages {
+		if strings.EqualFold(message.Level, serverHealthLevelError) {
+			errorMessages = append(errorMessages, message.Message)
+		}
+	}
+
+	if len(errorMessages) != 0 {
+		return "", nil, fmt.Errorf("GoCD server has health messages of level error: %s", strings.Join(errorMessages, "; "))
+	}
+
+	return health["health"], messages, nil
+}
+
+func flattenServerHealthMessages(messages []gocd.ServerHealth) []map[string]interface{} {
+	flattenedMessages := make([]map[string]interface{}, 0, len(messages))
+	for _, message := range messages {
+		flattenedMessages = append(flattenedMessages, map[string]interface{}{
+			utils.TerraformResourceLevel:   message.Level,
+			utils.TerraformResourceMessage: message.Message,
+			utils.TerraformResourceDetail:  message.Detail,
+			utils.TerraformResourceTime:    message.Time,
+		})
+	}
+
+	return flattenedMessages
+}
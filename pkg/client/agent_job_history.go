@@ -0,0 +1,60 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// minAgentJobHistoryPageSize is the smallest page size accepted by GoCD server for the job run history of an agent.
+const minAgentJobHistoryPageSize = 10
+
+// AgentJobInstance is a job that was run on an agent, counters and timestamps are left undecoded
+// since GoCD server represents them differently across versions.
+type AgentJobInstance struct {
+	PipelineName     string                    `json:"pipeline_name,omitempty"`
+	PipelineCounter  interface{}               `json:"pipeline_counter,omitempty"`
+	StageName        string                    `json:"stage_name,omitempty"`
+	StageCounter     interface{}               `json:"stage_counter,omitempty"`
+	JobName          string                    `json:"job_name,omitempty"`
+	Result           string                    `json:"result,omitempty"`
+	Rerun            bool                      `json:"rerun,omitempty"`
+	StateTransitions []AgentJobStateTransition `json:"job_state_transitions,omitempty"`
+}
+
+type AgentJobStateTransition struct {
+	State           string      `json:"state,omitempty"`
+	StateChangeTime interface{} `json:"state_change_time,omitempty"`
+}
+
+type agentJobHistory struct {
+	Jobs []AgentJobInstance `json:"jobs,omitempty"`
+}
+
+// GetAgentJobHistory fetches the most recent jobs run on the agent, latest first. gocd-sdk-go does not support it,
+// so it is fetched with the same auth as the client.
+func (g GoCD) GetAgentJobHistory(ctx context.Context, uuid string, pageSize int) ([]AgentJobInstance, error) {
+	var history agentJobHistory
+
+	if pageSize < minAgentJobHistoryPageSize {
+		pageSize = minAgentJobHistoryPageSize
+	}
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetAgentJobHistory",
+		segments: []string{"api", "agents", uuid, "job_run_history"},
+		query:    url.Values{"page_size": []string{strconv.Itoa(pageSize)}, "sort_order": []string{"DESC"}},
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v1+json"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting job run history of agent '%s' errored with: %w", uuid, err)
+	}
+
+	if err = json.Unmarshal(content, &history); err != nil {
+		return nil, fmt.Errorf("decoding job run history of agent '%s' errored with: %w", uuid, err)
+	}
+
+	return history.Jobs, nil
+}
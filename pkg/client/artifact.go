@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// Artifact identifies a file uploaded as an artifact by a job of a pipeline instance.
+type Artifact struct {
+	Pipeline        string
+	PipelineCounter int
+	Stage           string
+	StageCounter    string
+	Job             string
+	Path            string
+}
+
+func (a Artifact) String() string {
+	return fmt.Sprintf("%s/%d/%s/%s/%s/%s", a.Pipeline, a.PipelineCounter, a.Stage, a.StageCounter, a.Job, strings.TrimPrefix(a.Path, "/"))
+}
+
+// ValidateArtifactPath validates the path of the artifact relative to the artifacts of the job, it errors on empty, `.` and `..`
+// segments, since they would either escape the artifacts of the job or be resolved by GoCD server to a different file.
+func ValidateArtifactPath(path string) error {
+	for _, segment := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		switch segment {
+		case "":
+			return fmt.Errorf("path of the artifact '%s' has an empty segment", path)
+		case ".", "..":
+			return fmt.Errorf("path of the artifact '%s' has the segment '%s', it should be relative to the artifacts of the job", path, segment)
+		}
+	}
+
+	return nil
+}
+
+// DownloadArtifact downloads the artifact from GoCD server, it errors when the artifact is larger than maxSize bytes.
+// gocd-sdk-go does not support downloading the artifacts, so it is fetched from the files endpoint.
+func (g GoCD) DownloadArtifact(ctx context.Context, artifact Artifact, maxSize int64) ([]byte, error) {
+	if err := ValidateArtifactPath(artifact.Path); err != nil {
+		return nil, err
+	}
+
+	segments := []string{"files", artifact.Pipeline, fmt.Sprintf("%d", artifact.PipelineCounter), artifact.Stage, artifact.StageCounter, artifact.Job}
+	segments = append(segments, strings.Split(strings.TrimPrefix(artifact.Path, "/"), "/")...)
+
+	content, err := g.get(ctx, rawRequest{method: "DownloadArtifact", segments: segments, maxSize: maxSize})
+	if err != nil {
+		return nil, fmt.Errorf("downloading artifact '%s' errored with: %w", artifact, err)
+	}
+
+	return content, nil
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDownloadArtifact(t *testing.T) {
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		paths = append(paths, req.URL.EscapedPath())
+		_, _ = writer.Write([]byte("sha256:abc"))
+	}))
+
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedPath string
+		expectErr    bool
+	}{
+		{name: "relative path", path: "build/image digest.txt", expectedPath: "/go/files/build/3/test/1/unit/build/image%20digest.txt"},
+		{name: "leading slash is trimmed", path: "/out.txt", expectedPath: "/go/files/build/3/test/1/unit/out.txt"},
+		{name: "empty path", path: "", expectErr: true},
+		{name: "empty segment", path: "build//out.txt", expectErr: true},
+		{name: "trailing slash", path: "build/", expectErr: true},
+		{name: "current directory segment", path: "./out.txt", expectErr: true},
+		{name: "parent directory segment", path: "../../../../other/out.txt", expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			paths = nil
+			goCDClient := GoCD{baseURL: server.URL + "/go"}
+			artifact := Artifact{Pipeline: "build", PipelineCounter: 3, Stage: "test", StageCounter: "1", Job: "unit", Path: test.path}
+
+			_, err := goCDClient.DownloadArtifact(context.Background(), artifact, 1024)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+
+			if test.expectErr {
+				if len(paths) != 0 {
+					t.Errorf("expected no request for an invalid path, got: %v", paths)
+				}
+
+				return
+			}
+
+			if len(paths) != 1 || paths[0] != test.expectedPath {
+				t.Errorf("expected request to '%s', got: %v", test.expectedPath, paths)
+			}
+		})
+	}
+}
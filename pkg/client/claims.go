@@ -0,0 +1,37 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+)
+
+// pipelineGroupClaims records the group claimed for every pipeline by the resources planned with the provider instance,
+// it starts empty on every plan as terraform configures a new provider instance for each of them.
+type pipelineGroupClaims struct {
+	mutex  sync.Mutex
+	groups map[string]string
+}
+
+// ClaimPipeline records the group claimed for the pipeline by a resource being planned, it errors when the pipeline
+// is already claimed by a different group in the same configuration, since a pipeline can be part of only one group.
+func (g GoCD) ClaimPipeline(pipeline, group string) error {
+	if g.pipelineGroupClaims == nil {
+		return nil
+	}
+
+	g.pipelineGroupClaims.mutex.Lock()
+	defer g.pipelineGroupClaims.mutex.Unlock()
+
+	if g.pipelineGroupClaims.groups == nil {
+		g.pipelineGroupClaims.groups = make(map[string]string)
+	}
+
+	if claimedGroup, ok := g.pipelineGroupClaims.groups[pipeline]; ok && claimedGroup != group {
+		return fmt.Errorf("pipeline '%s' is claimed by both pipeline groups '%s' and '%s', a pipeline can be part of only one group",
+			pipeline, claimedGroup, group)
+	}
+
+	g.pipelineGroupClaims.groups[pipeline] = group
+
+	return nil
+}
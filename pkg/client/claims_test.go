@@ -0,0 +1,53 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestClaimPipeline(t *testing.T) {
+	type claim struct {
+		pipeline  string
+		group     string
+		expectErr bool
+	}
+
+	tests := []struct {
+		name   string
+		claims []claim
+	}{
+		{
+			name:   "pipelines claimed by their groups",
+			claims: []claim{{pipeline: "build", group: "ci"}, {pipeline: "deploy", group: "cd"}},
+		},
+		{
+			name:   "pipeline claimed again by the same group",
+			claims: []claim{{pipeline: "build", group: "ci"}, {pipeline: "build", group: "ci"}},
+		},
+		{
+			name:   "pipeline claimed by two different groups",
+			claims: []claim{{pipeline: "build", group: "ci"}, {pipeline: "build", group: "cd", expectErr: true}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			goCDClient := GoCD{pipelineGroupClaims: &pipelineGroupClaims{}}
+
+			for _, claim := range test.claims {
+				if err := goCDClient.ClaimPipeline(claim.pipeline, claim.group); (err != nil) != claim.expectErr {
+					t.Errorf("claiming pipeline '%s' for group '%s', expected error: %t, got: %v", claim.pipeline, claim.group, claim.expectErr, err)
+				}
+			}
+		})
+	}
+
+	t.Run("claims are not shared across the provider instances", func(t *testing.T) {
+		if err := (GoCD{pipelineGroupClaims: &pipelineGroupClaims{}}).ClaimPipeline("build", "ci"); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := (GoCD{pipelineGroupClaims: &pipelineGroupClaims{}}).ClaimPipeline("build", "cd"); err != nil {
+			t.Errorf("expected the claims of a different provider instance to be ignored, got: %v", err)
+		}
+	})
+}
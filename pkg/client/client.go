@@ -3,8 +3,12 @@ package client
 import (
 	"context"
 	"errors"
-	"log"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/nikhilsbhat/gocd-sdk-go"
@@ -12,7 +16,42 @@ import (
 	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
 )
 
-func GetGoCDClient(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+const (
+	OnConflictRetry = "retry"
+	OnConflictFail  = "fail"
+)
+
+// GoCD is shared with all the resources and data sources as meta, it embeds the gocd-sdk-go client
+// so that it can still be used as gocd.GoCd, along with the provider level configs.
+type GoCD struct {
+	gocd.GoCd
+	// OnConflict decides how the updates rejected by GoCD due to an outdated etag are handled, either OnConflictRetry or OnConflictFail.
+	OnConflict string
+	// VersionInfo is the version of GoCD server fetched while configuring the provider, it is nil when it could not be fetched
+	// or when `skip_check` is set, use GetServerVersion to have it fetched when needed.
+	VersionInfo *gocd.VersionInfo
+	// CheckPermissions makes the resources check during plan whether the user can administer the entities they manage.
+	CheckPermissions bool
+	logLevel         string
+	// secrets are masked from the logs of the calls made to GoCD.
+	secrets []string
+	// baseURL, auth and transport are used for the calls gocd-sdk-go does not support.
+	baseURL   string
+	auth      gocd.Auth
+	transport http.RoundTripper
+	// forwarder makes the requests of gocd-sdk-go clients over the transport, with the context each client is bound to.
+	forwarder *sdkForwarder
+	// permissions is shared by the copies of the client, so that the permissions are fetched only once.
+	permissions *permissionsCache
+	// pipelineGroupClaims is shared by the copies of the client, so that the pipelines claimed by all the resources are known.
+	pipelineGroupClaims *pipelineGroupClaims
+	// version is shared by the copies of the client, so that the version is fetched only once when it is needed.
+	version *versionCache
+	// pipelineConfigs is shared by the copies of the client, so that the configs of all the pipelines are fetched only once.
+	pipelineConfigs *pipelineConfigsCache
+}
+
+func GetGoCDClient(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	clientCfg := struct {
 		url         string
 		username    string
@@ -23,6 +62,14 @@ func GetGoCDClient(_ context.Context, d *schema.ResourceData) (interface{}, diag
 		ca          []byte
 	}{}
 
+	if loglevel := d.Get("loglevel").(string); len(loglevel) == 0 {
+		clientCfg.loglevel = "info"
+	} else {
+		clientCfg.loglevel = loglevel
+	}
+
+	ctx = newLoggingContext(ctx, clientCfg.loglevel, nil)
+
 	if baseURL := d.Get("base_url").(string); len(baseURL) == 0 {
 		diag.Errorf("'base_url' was not set")
 	} else {
@@ -59,31 +106,97 @@ func GetGoCDClient(_ context.Context, d *schema.ResourceData) (interface{}, diag
 		clientCfg.ca = []byte(caFileContent)
 	}
 
-	if loglevel := d.Get("loglevel").(string); len(loglevel) == 0 {
-		clientCfg.loglevel = "info"
-	} else {
-		clientCfg.loglevel = loglevel
+	transportCfg, err := getTransportConfig(d)
+	if err != nil {
+		return nil, diag.Errorf("reading transport configs errored with: %v", err)
+	}
+
+	cliCfg, err := getCLIAuthConfig(ctx, utils.String(d.Get(utils.TerraformResourceConfigPath)), utils.String(d.Get(utils.TerraformResourceProfile)))
+	if err != nil {
+		return nil, diag.Errorf("%v", err)
+	}
+
+	// explicit arguments and GOCD_* environment variables take precedence over the gocd cli auth config.
+	if len(clientCfg.url) == 0 {
+		clientCfg.url = cliCfg.URL
 	}
 
+	if len(clientCfg.password) == 0 && len(clientCfg.bearerToken) == 0 {
+		if len(clientCfg.username) == 0 {
+			clientCfg.username = cliCfg.Username
+		}
+
+		clientCfg.password = cliCfg.Password
+		clientCfg.bearerToken = cliCfg.BearerToken
+	}
+
+	if len(clientCfg.ca) == 0 && len(transportCfg.ca) == 0 && len(cliCfg.CAPath) != 0 {
+		if transportCfg.ca, err = os.ReadFile(cliCfg.CAPath); err != nil {
+			return nil, diag.Errorf("reading CA file '%s' from gocd cli auth config errored with: %v", cliCfg.CAPath, err)
+		}
+	}
+
+	if len(clientCfg.url) == 0 {
+		return nil, diag.Errorf("'base_url' was not set, it should be passed as an argument, environment variable 'GOCD_BASE_URL' or through gocd cli auth config")
+	}
+
+	if len(clientCfg.ca) == 0 {
+		clientCfg.ca = transportCfg.ca
+	}
+
+	transportCfg.ca = clientCfg.ca
+
 	goCDAuth := gocd.Auth{
 		UserName:    clientCfg.username,
 		Password:    clientCfg.password,
 		BearerToken: clientCfg.bearerToken,
 	}
 
-	goCDClient := gocd.NewClient(clientCfg.url, goCDAuth, clientCfg.loglevel, clientCfg.ca)
+	// gocd-sdk-go skips verifying the certificate of GoCD server when no CA is passed, which is retained since its requests are
+	// now made over the transport of the provider.
+	if len(transportCfg.ca) == 0 {
+		transportCfg.insecureSkipVerify = true
+	}
+
+	if transportCfg.customised() {
+		tflog.SubsystemInfo(ctx, SubsystemClient, "requests to GoCD server would be made with the custom transport", map[string]interface{}{
+			"base_url": clientCfg.url,
+		})
+	}
+
+	transport, err := transportCfg.transport()
+	if err != nil {
+		return nil, diag.Errorf("configuring transport for GoCD server errored with: %v", err)
+	}
 
 	retryConfigs := getRetryConfig(d.Get(utils.TerraformResourceRetries))
 	if retryConfigs.count != 0 {
-		log.Printf("setting API retry count to %d:\n", retryConfigs.count)
-		goCDClient.SetRetryCount(retryConfigs.count)
+		tflog.SubsystemDebug(ctx, SubsystemClient, "setting API retry count", map[string]interface{}{"count": retryConfigs.count})
 	}
 
 	if retryConfigs.waitTime != 0 {
-		log.Printf("setting API retry wait time to %d:\n", retryConfigs.waitTime)
-		goCDClient.SetRetryWaitTime(retryConfigs.waitTime)
+		tflog.SubsystemDebug(ctx, SubsystemClient, "setting API retry wait time", map[string]interface{}{"wait_time": retryConfigs.waitTime})
 	}
 
+	// the clients of gocd-sdk-go are created by the forwarder, one per context the provider makes the calls with.
+	forwarder, err := newSDKForwarder(clientCfg.url, transport, func(baseURL string) gocd.GoCd {
+		goCDClient := gocd.NewClient(baseURL, goCDAuth, sdkLogLevel, nil)
+		if retryConfigs.count != 0 {
+			goCDClient.SetRetryCount(retryConfigs.count)
+		}
+
+		if retryConfigs.waitTime != 0 {
+			goCDClient.SetRetryWaitTime(retryConfigs.waitTime)
+		}
+
+		return goCDClient
+	})
+	if err != nil {
+		return nil, diag.Errorf("starting the forwarder for the requests of gocd-sdk-go errored with: %v", err)
+	}
+
+	goCDClient := forwarder.client(context.Background())
+
 	if !clientCfg.skipCheck {
 		if _, err := goCDClient.GetServerHealth(); err != nil {
 			if !errors.Is(err, goErr.MarshalError{}) {
@@ -92,7 +205,27 @@ func GetGoCDClient(_ context.Context, d *schema.ResourceData) (interface{}, diag
 		}
 	}
 
-	return goCDClient, nil
+	goCD := GoCD{
+		GoCd:                goCDClient,
+		OnConflict:          utils.String(d.Get(utils.TerraformResourceOnConflict)),
+		CheckPermissions:    utils.Bool(d.Get(utils.TerraformResourceCheckPermissions)),
+		logLevel:            clientCfg.loglevel,
+		secrets:             []string{clientCfg.password, clientCfg.bearerToken},
+		baseURL:             clientCfg.url,
+		auth:                goCDAuth,
+		transport:           transport,
+		forwarder:           forwarder,
+		permissions:         &permissionsCache{},
+		pipelineGroupClaims: &pipelineGroupClaims{},
+		version:             &versionCache{},
+		pipelineConfigs:     &pipelineConfigsCache{},
+	}
+
+	if !clientCfg.skipCheck {
+		goCD.VersionInfo = getVersionInfo(ctx, goCD.WithContext(ctx))
+	}
+
+	return goCD, nil
 }
 
 type retryConfig struct {
@@ -116,3 +249,64 @@ func getRetryConfig(retryConfigs interface{}) retryConfig {
 		waitTime: flattenedRetryConfigs[utils.TerraformResourceWaitTime].(int),
 	}
 }
+
+func getTransportConfig(d *schema.ResourceData) (transportConfig, error) {
+	transportCfg := transportConfig{
+		insecureSkipVerify: utils.Bool(d.Get(utils.TerraformResourceInsecureSkipVerify)),
+		proxyURL:           utils.String(d.Get(utils.TerraformResourceProxyURL)),
+		headers:            make(map[string]string),
+	}
+
+	if caFilePath := utils.String(d.Get(utils.TerraformResourceCAFilePath)); len(caFilePath) != 0 {
+		ca, err := os.ReadFile(caFilePath)
+		if err != nil {
+			return transportCfg, fmt.Errorf("reading CA file '%s' errored with: %w", caFilePath, err)
+		}
+
+		transportCfg.ca = ca
+	}
+
+	clientCert, err := readFileOrContent(utils.String(d.Get(utils.TerraformResourceClientCert)))
+	if err != nil {
+		return transportCfg, fmt.Errorf("reading client certificate errored with: %w", err)
+	}
+
+	clientKey, err := readFileOrContent(utils.String(d.Get(utils.TerraformResourceClientKey)))
+	if err != nil {
+		return transportCfg, fmt.Errorf("reading client key errored with: %w", err)
+	}
+
+	transportCfg.clientCert = clientCert
+	transportCfg.clientKey = clientKey
+
+	if requestTimeout := utils.String(d.Get(utils.TerraformResourceRequestTimeout)); len(requestTimeout) != 0 {
+		timeout, err := time.ParseDuration(requestTimeout)
+		if err != nil {
+			return transportCfg, fmt.Errorf("parsing request timeout '%s' errored with: %w", requestTimeout, err)
+		}
+
+		transportCfg.requestTimeout = timeout
+	}
+
+	for key, value := range d.Get(utils.TerraformResourceHeaders).(map[string]interface{}) {
+		transportCfg.headers[key] = utils.String(value)
+	}
+
+	return transportCfg, nil
+}
+
+// getVersionInfo fetches the version of GoCD server, failing to fetch it is not an error since it is used only to check the features.
+func getVersionInfo(ctx context.Context, goCDClient gocd.GoCd) *gocd.VersionInfo {
+	versionInfo, err := goCDClient.GetVersionInfo()
+	if err != nil {
+		tflog.SubsystemWarn(ctx, SubsystemClient, "fetching version of GoCD server errored, features would not be checked against it", map[string]interface{}{
+			"error": err.Error(),
+		})
+
+		return nil
+	}
+
+	tflog.SubsystemInfo(ctx, SubsystemClient, "connected to GoCD server", map[string]interface{}{"version": versionInfo.FullVersion})
+
+	return &versionInfo
+}
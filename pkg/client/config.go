@@ -0,0 +1,82 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultCLIProfile = "default"
+
+// cliAuthConfig is the auth config of the gocd cli, the server details at the top level are of the default profile,
+// and the ones under profiles are of the named profiles.
+type cliAuthConfig struct {
+	URL         string                   `yaml:"url,omitempty"`
+	Username    string                   `yaml:"username,omitempty"`
+	Password    string                   `yaml:"password,omitempty"`
+	BearerToken string                   `yaml:"bearer-token,omitempty"`
+	CAPath      string                   `yaml:"ca-path,omitempty"`
+	Profiles    map[string]cliAuthConfig `yaml:"profiles,omitempty"`
+}
+
+func getDefaultCLIConfigPath(ctx context.Context) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		tflog.SubsystemWarn(ctx, SubsystemClient, "locating home directory errored, gocd cli auth config would not be loaded", map[string]interface{}{
+			"error": err.Error(),
+		})
+
+		return ""
+	}
+
+	return filepath.Join(home, ".gocd", "auth_config.yaml")
+}
+
+// getCLIAuthConfig loads the server details of the profile from the gocd cli auth config.
+// Missing config at the default path is not an error, since it is used only when the server details are not passed otherwise.
+func getCLIAuthConfig(ctx context.Context, configPath, profile string) (cliAuthConfig, error) {
+	explicit := len(configPath) != 0 || len(profile) != 0
+	if len(configPath) == 0 {
+		configPath = getDefaultCLIConfigPath(ctx)
+	}
+
+	if len(configPath) == 0 {
+		return cliAuthConfig{}, nil
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) && !explicit {
+			return cliAuthConfig{}, nil
+		}
+
+		return cliAuthConfig{}, fmt.Errorf("reading gocd cli auth config '%s' errored with: %w", configPath, err)
+	}
+
+	var authConfig cliAuthConfig
+	if err = yaml.Unmarshal(content, &authConfig); err != nil {
+		return cliAuthConfig{}, fmt.Errorf("decoding gocd cli auth config '%s' errored with: %w", configPath, err)
+	}
+
+	if len(profile) == 0 || profile == defaultCLIProfile {
+		return authConfig, nil
+	}
+
+	profileConfig, ok := authConfig.Profiles[profile]
+	if !ok {
+		return cliAuthConfig{}, fmt.Errorf("profile '%s' was not found in gocd cli auth config '%s'", profile, configPath)
+	}
+
+	tflog.SubsystemInfo(ctx, SubsystemClient, "using profile from gocd cli auth config", map[string]interface{}{
+		"profile":     profile,
+		"config_path": configPath,
+	})
+
+	return profileConfig, nil
+}
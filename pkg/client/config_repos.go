@@ -0,0 +1,46 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PreflightResult is the result of validating the files of a config repository with its plugin.
+type PreflightResult struct {
+	Valid  bool     `json:"valid,omitempty"`
+	Errors []string `json:"errors,omitempty"`
+}
+
+// ConfigRepoPreflightCheck validates the files, by their names and contents, with the config repo plugin. When repoID is set
+// they are validated as if they were pushed to that config repository. gocd-sdk-go reads the files to be validated from the disk,
+// so they are sent with the same auth as the client.
+func (g GoCD) ConfigRepoPreflightCheck(ctx context.Context, pluginID, repoID string, files map[string]string) (PreflightResult, error) {
+	var result PreflightResult
+
+	query := url.Values{"pluginId": []string{pluginID}}
+	if len(repoID) != 0 {
+		query.Set("repoId", repoID)
+	}
+
+	content, err := g.do(ctx, rawRequest{
+		method:    "ConfigRepoPreflightCheck",
+		verb:      http.MethodPost,
+		segments:  []string{"api", "admin", "config_repo_ops", "preflight"},
+		query:     query,
+		headers:   map[string]string{"Accept": "application/vnd.go.cd.v1+json"},
+		formField: "files[]",
+		files:     files,
+	})
+	if err != nil {
+		return result, fmt.Errorf("running preflight check with plugin '%s' errored with: %w", pluginID, err)
+	}
+
+	if err = json.Unmarshal(content, &result); err != nil {
+		return result, fmt.Errorf("decoding result of preflight check with plugin '%s' errored with: %w", pluginID, err)
+	}
+
+	return result, nil
+}
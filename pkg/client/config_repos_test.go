@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestConfigRepoPreflightCheck(t *testing.T) {
+	var files map[string]string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.Path != "/go/api/admin/config_repo_ops/preflight" || req.URL.Query().Get("pluginId") != "yaml.config.plugin" {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		if req.URL.Query().Get("repoId") == "missing" {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if err := req.ParseMultipartForm(maxResponseSize); err != nil {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		files = make(map[string]string)
+		for _, header := range req.MultipartForm.File["files[]"] {
+			file, _ := header.Open()
+			content, _ := io.ReadAll(file)
+			files[header.Filename] = string(content)
+		}
+
+		_, _ = writer.Write([]byte(`{"errors": ["stage 'test' has no jobs"], "valid": false}`))
+	}))
+
+	defer server.Close()
+
+	goCDClient := GoCD{baseURL: server.URL + "/go"}
+	expectedFiles := map[string]string{"build.gocd.yaml": "format_version: 10", "deploy.gocd.yaml": "format_version: 10\npipelines: {}"}
+
+	result, err := goCDClient.ConfigRepoPreflightCheck(context.Background(), "yaml.config.plugin", "", expectedFiles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if d := cmp.Diff(expectedFiles, files); d != "" {
+		t.Errorf("files mismatch (-expected +actual):\n%s", d)
+	}
+
+	if d := cmp.Diff(PreflightResult{Errors: []string{"stage 'test' has no jobs"}}, result); d != "" {
+		t.Errorf("result mismatch (-expected +actual):\n%s", d)
+	}
+
+	_, err = goCDClient.ConfigRepoPreflightCheck(context.Background(), "yaml.config.plugin", "missing", expectedFiles)
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
@@ -0,0 +1,505 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// WithContext returns the GoCD client whose calls are cancelled as soon as the context is done (cancelled or timed out).
+// gocd-sdk-go does not accept a context, so its requests are made through the forwarder, with the context the client is bound to.
+// A call cancelled after the request reached GoCD server may still be applied by it, which would be detected on the next refresh.
+// The calls are logged along with their request and response at TRACE under the subsystem SubsystemAPI.
+func (g GoCD) WithContext(ctx context.Context) gocd.GoCd {
+	goCDClient := g.GoCd
+	if g.forwarder != nil {
+		goCDClient = g.forwarder.client(ctx)
+	}
+
+	return contextClient{GoCd: goCDClient, ctx: newLoggingContext(ctx, g.logLevel, g.secrets)}
+}
+
+type contextClient struct {
+	gocd.GoCd
+	ctx context.Context //nolint:containedctx
+}
+
+func valueWithContext[T any](ctx context.Context, method string, req request, call func() (T, error)) (T, error) {
+	var empty T
+	if err := ctx.Err(); err != nil {
+		return empty, err
+	}
+
+	logAPIRequest(ctx, method, req)
+
+	start := time.Now()
+
+	value, err := call()
+	if ctxErr := ctx.Err(); err != nil && ctxErr != nil {
+		err = ctxErr
+	}
+
+	logAPIResponse(ctx, method, value, err, time.Since(start))
+
+	return value, err
+}
+
+func callWithContext(ctx context.Context, method string, req request, call func() error) error {
+	_, err := valueWithContext(ctx, method, req, func() (struct{}, error) {
+		return struct{}{}, call()
+	})
+
+	return err
+}
+
+func (c contextClient) GetAgent(agentID string) (gocd.Agent, error) {
+	return valueWithContext(c.ctx, "GetAgent", request{"agentID": agentID}, func() (gocd.Agent, error) {
+		return c.GoCd.GetAgent(agentID)
+	})
+}
+
+func (c contextClient) UpdateAgent(agent gocd.Agent) error {
+	return callWithContext(c.ctx, "UpdateAgent", request{"agent": agent}, func() error {
+		return c.GoCd.UpdateAgent(agent)
+	})
+}
+
+func (c contextClient) UpdateAgentBulk(agent gocd.Agent) error {
+	return callWithContext(c.ctx, "UpdateAgentBulk", request{"agent": agent}, func() error {
+		return c.GoCd.UpdateAgentBulk(agent)
+	})
+}
+
+func (c contextClient) GetArtifactStore(name string) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "GetArtifactStore", request{"name": name}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.GetArtifactStore(name)
+	})
+}
+
+func (c contextClient) CreateArtifactStore(store gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "CreateArtifactStore", request{"store": store}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.CreateArtifactStore(store)
+	})
+}
+
+func (c contextClient) UpdateArtifactStore(store gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "UpdateArtifactStore", request{"store": store}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.UpdateArtifactStore(store)
+	})
+}
+
+func (c contextClient) DeleteArtifactStore(name string) error {
+	return callWithContext(c.ctx, "DeleteArtifactStore", request{"name": name}, func() error {
+		return c.GoCd.DeleteArtifactStore(name)
+	})
+}
+
+func (c contextClient) GetAuthConfig(name string) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "GetAuthConfig", request{"name": name}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.GetAuthConfig(name)
+	})
+}
+
+func (c contextClient) CreateAuthConfig(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "CreateAuthConfig", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.CreateAuthConfig(cfg)
+	})
+}
+
+func (c contextClient) UpdateAuthConfig(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "UpdateAuthConfig", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.UpdateAuthConfig(cfg)
+	})
+}
+
+func (c contextClient) DeleteAuthConfig(name string) error {
+	return callWithContext(c.ctx, "DeleteAuthConfig", request{"name": name}, func() error {
+		return c.GoCd.DeleteAuthConfig(name)
+	})
+}
+
+func (c contextClient) GetBackup(id string) (gocd.BackupStats, error) {
+	return valueWithContext(c.ctx, "GetBackup", request{"id": id}, func() (gocd.BackupStats, error) {
+		return c.GoCd.GetBackup(id)
+	})
+}
+
+func (c contextClient) ScheduleBackup() (map[string]string, error) {
+	return valueWithContext(c.ctx, "ScheduleBackup", nil, func() (map[string]string, error) {
+		return c.GoCd.ScheduleBackup()
+	})
+}
+
+func (c contextClient) GetBackupConfig() (gocd.BackupConfig, error) {
+	return valueWithContext(c.ctx, "GetBackupConfig", nil, func() (gocd.BackupConfig, error) {
+		return c.GoCd.GetBackupConfig()
+	})
+}
+
+func (c contextClient) CreateOrUpdateBackupConfig(backup gocd.BackupConfig) error {
+	return callWithContext(c.ctx, "CreateOrUpdateBackupConfig", request{"backup": backup}, func() error {
+		return c.GoCd.CreateOrUpdateBackupConfig(backup)
+	})
+}
+
+func (c contextClient) DeleteBackupConfig() error {
+	return callWithContext(c.ctx, "DeleteBackupConfig", nil, func() error {
+		return c.GoCd.DeleteBackupConfig()
+	})
+}
+
+func (c contextClient) GetClusterProfile(name string) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "GetClusterProfile", request{"name": name}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.GetClusterProfile(name)
+	})
+}
+
+func (c contextClient) CreateClusterProfile(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "CreateClusterProfile", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.CreateClusterProfile(cfg)
+	})
+}
+
+func (c contextClient) UpdateClusterProfile(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "UpdateClusterProfile", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.UpdateClusterProfile(cfg)
+	})
+}
+
+func (c contextClient) DeleteClusterProfile(name string) error {
+	return callWithContext(c.ctx, "DeleteClusterProfile", request{"name": name}, func() error {
+		return c.GoCd.DeleteClusterProfile(name)
+	})
+}
+
+func (c contextClient) GetElasticAgentProfile(name string) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "GetElasticAgentProfile", request{"name": name}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.GetElasticAgentProfile(name)
+	})
+}
+
+func (c contextClient) CreateElasticAgentProfile(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "CreateElasticAgentProfile", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.CreateElasticAgentProfile(cfg)
+	})
+}
+
+func (c contextClient) UpdateElasticAgentProfile(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "UpdateElasticAgentProfile", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.UpdateElasticAgentProfile(cfg)
+	})
+}
+
+func (c contextClient) DeleteElasticAgentProfile(name string) error {
+	return callWithContext(c.ctx, "DeleteElasticAgentProfile", request{"name": name}, func() error {
+		return c.GoCd.DeleteElasticAgentProfile(name)
+	})
+}
+
+func (c contextClient) GetConfigRepo(repo string) (gocd.ConfigRepo, error) {
+	return valueWithContext(c.ctx, "GetConfigRepo", request{"repo": repo}, func() (gocd.ConfigRepo, error) {
+		return c.GoCd.GetConfigRepo(repo)
+	})
+}
+
+func (c contextClient) CreateConfigRepo(repo gocd.ConfigRepo) error {
+	return callWithContext(c.ctx, "CreateConfigRepo", request{"repo": repo}, func() error {
+		return c.GoCd.CreateConfigRepo(repo)
+	})
+}
+
+func (c contextClient) UpdateConfigRepo(repo gocd.ConfigRepo) (string, error) {
+	return valueWithContext(c.ctx, "UpdateConfigRepo", request{"repo": repo}, func() (string, error) {
+		return c.GoCd.UpdateConfigRepo(repo)
+	})
+}
+
+func (c contextClient) DeleteConfigRepo(repo string) error {
+	return callWithContext(c.ctx, "DeleteConfigRepo", request{"repo": repo}, func() error {
+		return c.GoCd.DeleteConfigRepo(repo)
+	})
+}
+
+func (c contextClient) GetEnvironment(name string) (gocd.Environment, error) {
+	return valueWithContext(c.ctx, "GetEnvironment", request{"name": name}, func() (gocd.Environment, error) {
+		return c.GoCd.GetEnvironment(name)
+	})
+}
+
+func (c contextClient) CreateEnvironment(env gocd.Environment) error {
+	return callWithContext(c.ctx, "CreateEnvironment", request{"env": env}, func() error {
+		return c.GoCd.CreateEnvironment(env)
+	})
+}
+
+func (c contextClient) UpdateEnvironment(env gocd.Environment) (gocd.Environment, error) {
+	return valueWithContext(c.ctx, "UpdateEnvironment", request{"env": env}, func() (gocd.Environment, error) {
+		return c.GoCd.UpdateEnvironment(env)
+	})
+}
+
+func (c contextClient) DeleteEnvironment(name string) error {
+	return callWithContext(c.ctx, "DeleteEnvironment", request{"name": name}, func() error {
+		return c.GoCd.DeleteEnvironment(name)
+	})
+}
+
+// EncryptText does not log the request, since it is the plain text value.
+func (c contextClient) EncryptText(value string) (gocd.Encrypted, error) {
+	return valueWithContext(c.ctx, "EncryptText", nil, func() (gocd.Encrypted, error) {
+		return c.GoCd.EncryptText(value)
+	})
+}
+
+func (c contextClient) GetPipelineConfig(name string) (gocd.PipelineConfig, error) {
+	return valueWithContext(c.ctx, "GetPipelineConfig", request{"name": name}, func() (gocd.PipelineConfig, error) {
+		return c.GoCd.GetPipelineConfig(name)
+	})
+}
+
+func (c contextClient) CreatePipeline(cfg gocd.PipelineConfig) (gocd.PipelineConfig, error) {
+	return valueWithContext(c.ctx, "CreatePipeline", request{"cfg": cfg}, func() (gocd.PipelineConfig, error) {
+		return c.GoCd.CreatePipeline(cfg)
+	})
+}
+
+func (c contextClient) UpdatePipelineConfig(cfg gocd.PipelineConfig) (gocd.PipelineConfig, error) {
+	return valueWithContext(c.ctx, "UpdatePipelineConfig", request{"cfg": cfg}, func() (gocd.PipelineConfig, error) {
+		return c.GoCd.UpdatePipelineConfig(cfg)
+	})
+}
+
+func (c contextClient) DeletePipeline(name string) error {
+	return callWithContext(c.ctx, "DeletePipeline", request{"name": name}, func() error {
+		return c.GoCd.DeletePipeline(name)
+	})
+}
+
+func (c contextClient) GetPipelineGroup(name string) (gocd.PipelineGroup, error) {
+	return valueWithContext(c.ctx, "GetPipelineGroup", request{"name": name}, func() (gocd.PipelineGroup, error) {
+		return c.GoCd.GetPipelineGroup(name)
+	})
+}
+
+func (c contextClient) CreatePipelineGroup(group gocd.PipelineGroup) error {
+	return callWithContext(c.ctx, "CreatePipelineGroup", request{"group": group}, func() error {
+		return c.GoCd.CreatePipelineGroup(group)
+	})
+}
+
+func (c contextClient) UpdatePipelineGroup(group gocd.PipelineGroup) (gocd.PipelineGroup, error) {
+	return valueWithContext(c.ctx, "UpdatePipelineGroup", request{"group": group}, func() (gocd.PipelineGroup, error) {
+		return c.GoCd.UpdatePipelineGroup(group)
+	})
+}
+
+func (c contextClient) DeletePipelineGroup(name string) error {
+	return callWithContext(c.ctx, "DeletePipelineGroup", request{"name": name}, func() error {
+		return c.GoCd.DeletePipelineGroup(name)
+	})
+}
+
+func (c contextClient) GetPluginInfo(name string) (gocd.Plugin, error) {
+	return valueWithContext(c.ctx, "GetPluginInfo", request{"name": name}, func() (gocd.Plugin, error) {
+		return c.GoCd.GetPluginInfo(name)
+	})
+}
+
+func (c contextClient) GetPluginsInfo() (gocd.PluginsInfo, error) {
+	return valueWithContext(c.ctx, "GetPluginsInfo", nil, func() (gocd.PluginsInfo, error) {
+		return c.GoCd.GetPluginsInfo()
+	})
+}
+
+func (c contextClient) GetPluginSettings(name string) (gocd.PluginSettings, error) {
+	return valueWithContext(c.ctx, "GetPluginSettings", request{"name": name}, func() (gocd.PluginSettings, error) {
+		return c.GoCd.GetPluginSettings(name)
+	})
+}
+
+func (c contextClient) CreatePluginSettings(settings gocd.PluginSettings) (gocd.PluginSettings, error) {
+	return valueWithContext(c.ctx, "CreatePluginSettings", request{"settings": settings}, func() (gocd.PluginSettings, error) {
+		return c.GoCd.CreatePluginSettings(settings)
+	})
+}
+
+func (c contextClient) UpdatePluginSettings(settings gocd.PluginSettings) (gocd.PluginSettings, error) {
+	return valueWithContext(c.ctx, "UpdatePluginSettings", request{"settings": settings}, func() (gocd.PluginSettings, error) {
+		return c.GoCd.UpdatePluginSettings(settings)
+	})
+}
+
+func (c contextClient) GetRole(name string) (gocd.Role, error) {
+	return valueWithContext(c.ctx, "GetRole", request{"name": name}, func() (gocd.Role, error) {
+		return c.GoCd.GetRole(name)
+	})
+}
+
+func (c contextClient) CreateRole(role gocd.Role) (gocd.Role, error) {
+	return valueWithContext(c.ctx, "CreateRole", request{"role": role}, func() (gocd.Role, error) {
+		return c.GoCd.CreateRole(role)
+	})
+}
+
+func (c contextClient) UpdateRole(role gocd.Role) (gocd.Role, error) {
+	return valueWithContext(c.ctx, "UpdateRole", request{"role": role}, func() (gocd.Role, error) {
+		return c.GoCd.UpdateRole(role)
+	})
+}
+
+func (c contextClient) DeleteRole(name string) error {
+	return callWithContext(c.ctx, "DeleteRole", request{"name": name}, func() error {
+		return c.GoCd.DeleteRole(name)
+	})
+}
+
+func (c contextClient) GetSecretConfig(name string) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "GetSecretConfig", request{"name": name}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.GetSecretConfig(name)
+	})
+}
+
+func (c contextClient) CreateSecretConfig(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "CreateSecretConfig", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.CreateSecretConfig(cfg)
+	})
+}
+
+func (c contextClient) UpdateSecretConfig(cfg gocd.CommonConfig) (gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "UpdateSecretConfig", request{"cfg": cfg}, func() (gocd.CommonConfig, error) {
+		return c.GoCd.UpdateSecretConfig(cfg)
+	})
+}
+
+func (c contextClient) DeleteSecretConfig(name string) error {
+	return callWithContext(c.ctx, "DeleteSecretConfig", request{"name": name}, func() error {
+		return c.GoCd.DeleteSecretConfig(name)
+	})
+}
+
+func (c contextClient) GetSystemAdmins() (gocd.SystemAdmins, error) {
+	return valueWithContext(c.ctx, "GetSystemAdmins", nil, func() (gocd.SystemAdmins, error) {
+		return c.GoCd.GetSystemAdmins()
+	})
+}
+
+func (c contextClient) UpdateSystemAdminsBulk(data gocd.Operations) (gocd.SystemAdmins, error) {
+	return valueWithContext(c.ctx, "UpdateSystemAdminsBulk", request{"data": data}, func() (gocd.SystemAdmins, error) {
+		return c.GoCd.UpdateSystemAdminsBulk(data)
+	})
+}
+
+func (c contextClient) GetServerHealth() (map[string]string, error) {
+	return valueWithContext(c.ctx, "GetServerHealth", nil, func() (map[string]string, error) {
+		return c.GoCd.GetServerHealth()
+	})
+}
+
+func (c contextClient) GetServerHealthMessages() ([]gocd.ServerHealth, error) {
+	return valueWithContext(c.ctx, "GetServerHealthMessages", nil, func() ([]gocd.ServerHealth, error) {
+		return c.GoCd.GetServerHealthMessages()
+	})
+}
+
+func (c contextClient) GetVersionInfo() (gocd.VersionInfo, error) {
+	return valueWithContext(c.ctx, "GetVersionInfo", nil, func() (gocd.VersionInfo, error) {
+		return c.GoCd.GetVersionInfo()
+	})
+}
+
+func (c contextClient) GetMaintenanceModeInfo() (gocd.Maintenance, error) {
+	return valueWithContext(c.ctx, "GetMaintenanceModeInfo", nil, func() (gocd.Maintenance, error) {
+		return c.GoCd.GetMaintenanceModeInfo()
+	})
+}
+
+func (c contextClient) EnableMaintenanceMode() error {
+	return callWithContext(c.ctx, "EnableMaintenanceMode", nil, func() error {
+		return c.GoCd.EnableMaintenanceMode()
+	})
+}
+
+func (c contextClient) DisableMaintenanceMode() error {
+	return callWithContext(c.ctx, "DisableMaintenanceMode", nil, func() error {
+		return c.GoCd.DisableMaintenanceMode()
+	})
+}
+
+func (c contextClient) GetPipelineGroups() ([]gocd.PipelineGroup, error) {
+	return valueWithContext(c.ctx, "GetPipelineGroups", nil, func() ([]gocd.PipelineGroup, error) {
+		return c.GoCd.GetPipelineGroups()
+	})
+}
+
+func (c contextClient) GetPipelineInstance(pipeline gocd.PipelineObject) (map[string]any, error) {
+	return valueWithContext(c.ctx, "GetPipelineInstance", request{"pipeline": pipeline}, func() (map[string]any, error) {
+		return c.GoCd.GetPipelineInstance(pipeline)
+	})
+}
+
+func (c contextClient) GetPipelineState(pipeline string) (gocd.PipelineState, error) {
+	return valueWithContext(c.ctx, "GetPipelineState", request{"pipeline": pipeline}, func() (gocd.PipelineState, error) {
+		return c.GoCd.GetPipelineState(pipeline)
+	})
+}
+
+func (c contextClient) GetMaterials() ([]gocd.Material, error) {
+	return valueWithContext(c.ctx, "GetMaterials", nil, func() ([]gocd.Material, error) {
+		return c.GoCd.GetMaterials()
+	})
+}
+
+func (c contextClient) MaterialTriggerUpdate(id string) (map[string]string, error) {
+	return valueWithContext(c.ctx, "MaterialTriggerUpdate", request{"id": id}, func() (map[string]string, error) {
+		return c.GoCd.MaterialTriggerUpdate(id)
+	})
+}
+
+func (c contextClient) GetEnvironments() ([]gocd.Environment, error) {
+	return valueWithContext(c.ctx, "GetEnvironments", nil, func() ([]gocd.Environment, error) {
+		return c.GoCd.GetEnvironments()
+	})
+}
+
+func (c contextClient) GetRoles() (gocd.RolesConfig, error) {
+	return valueWithContext(c.ctx, "GetRoles", nil, func() (gocd.RolesConfig, error) {
+		return c.GoCd.GetRoles()
+	})
+}
+
+func (c contextClient) GetClusterProfiles() (gocd.ProfilesConfig, error) {
+	return valueWithContext(c.ctx, "GetClusterProfiles", nil, func() (gocd.ProfilesConfig, error) {
+		return c.GoCd.GetClusterProfiles()
+	})
+}
+
+func (c contextClient) GetElasticAgentProfiles() (gocd.ProfilesConfig, error) {
+	return valueWithContext(c.ctx, "GetElasticAgentProfiles", nil, func() (gocd.ProfilesConfig, error) {
+		return c.GoCd.GetElasticAgentProfiles()
+	})
+}
+
+func (c contextClient) GetSecretConfigs() (gocd.SecretsConfig, error) {
+	return valueWithContext(c.ctx, "GetSecretConfigs", nil, func() (gocd.SecretsConfig, error) {
+		return c.GoCd.GetSecretConfigs()
+	})
+}
+
+func (c contextClient) GetConfigRepos() ([]gocd.ConfigRepo, error) {
+	return valueWithContext(c.ctx, "GetConfigRepos", nil, func() ([]gocd.ConfigRepo, error) {
+		return c.GoCd.GetConfigRepos()
+	})
+}
+
+func (c contextClient) GetArtifactStores() (gocd.ArtifactStoresConfig, error) {
+	return valueWithContext(c.ctx, "GetArtifactStores", nil, func() (gocd.ArtifactStoresConfig, error) {
+		return c.GoCd.GetArtifactStores()
+	})
+}
+
+func (c contextClient) GetAuthConfigs() ([]gocd.CommonConfig, error) {
+	return valueWithContext(c.ctx, "GetAuthConfigs", nil, func() ([]gocd.CommonConfig, error) {
+		return c.GoCd.GetAuthConfigs()
+	})
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestValueWithContext(t *testing.T) {
+	callErr := errors.New("call errored")
+
+	t.Run("call is not made when the context is already done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		called := false
+		_, err := valueWithContext(ctx, "Test", request{}, func() (string, error) {
+			called = true
+
+			return "", nil
+		})
+
+		if !errors.Is(err, context.Canceled) || called {
+			t.Errorf("expected the call to be skipped with context.Canceled, got: %v (called: %t)", err, called)
+		}
+	})
+
+	t.Run("error of the call is replaced with the error of the context once it is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		_, err := valueWithContext(ctx, "Test", request{}, func() (string, error) {
+			cancel()
+
+			return "", callErr
+		})
+
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got: %v", err)
+		}
+	})
+
+	t.Run("response of the call is returned", func(t *testing.T) {
+		value, err := valueWithContext(context.Background(), "Test", request{}, func() (string, error) {
+			return "response", callErr
+		})
+
+		if value != "response" || !errors.Is(err, callErr) {
+			t.Errorf("expected the response of the call, got: %s, %v", value, err)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// AddRemove are the entries to be added to and removed from a list in a single PATCH call.
+type AddRemove struct {
+	Add    []string `json:"add,omitempty"`
+	Remove []string `json:"remove,omitempty"`
+}
+
+// EnvironmentVariablesPatch are the environment variables to be added to and removed (by their names) from an environment.
+// A variable that is removed and added in the same call is replaced by the one added.
+type EnvironmentVariablesPatch struct {
+	Add    []gocd.EnvVars `json:"add,omitempty"`
+	Remove []string       `json:"remove,omitempty"`
+}
+
+// EnvironmentPatch are the changes to be applied on an environment in a single PATCH call.
+type EnvironmentPatch struct {
+	Pipelines            *AddRemove                 `json:"pipelines,omitempty"`
+	EnvironmentVariables *EnvironmentVariablesPatch `json:"environment_variables,omitempty"`
+}
+
+// PatchEnvironmentChanges applies the changes on the environment in a single call, GoCD applies them on its latest config
+// so the rest of the environment is left untouched without having to fetch and update it with its etag.
+// gocd-sdk-go patches neither the secure nor the encrypted environment variables, so it is patched with the same auth as the client.
+func (g GoCD) PatchEnvironmentChanges(ctx context.Context, name string, patch EnvironmentPatch) error {
+	_, err := g.do(ctx, rawRequest{
+		method:   "PatchEnvironmentChanges",
+		verb:     http.MethodPatch,
+		segments: []string{"api", "admin", "environments", name},
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v3+json"},
+		body:     patch,
+	})
+	if err != nil {
+		return fmt.Errorf("patching environment '%s' errored with: %w", name, err)
+	}
+
+	return nil
+}
+
+// PatchEnvironmentPipelines adds and removes the pipelines of the environment in a single call, leaving the rest of the environment untouched.
+func (g GoCD) PatchEnvironmentPipelines(ctx context.Context, name string, pipelines AddRemove) error {
+	return g.PatchEnvironmentChanges(ctx, name, EnvironmentPatch{Pipelines: &pipelines})
+}
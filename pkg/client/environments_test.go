@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func TestPatchEnvironmentPipelines(t *testing.T) {
+	var verb, path, accept, body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		content, _ := io.ReadAll(req.Body)
+		verb, path, accept, body = req.Method, req.URL.EscapedPath(), req.Header.Get("Accept"), string(content)
+
+		if req.URL.Path == "/go/api/admin/environments/missing" {
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	defer server.Close()
+
+	goCDClient := GoCD{baseURL: server.URL + "/go"}
+
+	if err := goCDClient.PatchEnvironmentPipelines(context.Background(), "sample env", AddRemove{Add: []string{"build"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if verb != http.MethodPatch || path != "/go/api/admin/environments/sample%20env" || accept != "application/vnd.go.cd.v3+json" {
+		t.Errorf("unexpected request '%s %s' with Accept '%s'", verb, path, accept)
+	}
+
+	var patch map[string]AddRemove
+	if err := json.Unmarshal([]byte(body), &patch); err != nil {
+		t.Fatalf("decoding request body '%s' errored with: %v", body, err)
+	}
+
+	if pipelines := patch["pipelines"]; len(pipelines.Add) != 1 || pipelines.Add[0] != "build" || len(pipelines.Remove) != 0 {
+		t.Errorf("unexpected request body: %s", body)
+	}
+
+	err := goCDClient.PatchEnvironmentPipelines(context.Background(), "missing", AddRemove{Remove: []string{"build"}})
+	if !IsNotFound(err) {
+		t.Errorf("expected not found error, got: %v", err)
+	}
+}
+
+func TestPatchEnvironmentChanges(t *testing.T) {
+	var body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, req *http.Request) {
+		content, _ := io.ReadAll(req.Body)
+		body = string(content)
+	}))
+
+	defer server.Close()
+
+	goCDClient := GoCD{baseURL: server.URL + "/go"}
+
+	patch := EnvironmentPatch{EnvironmentVariables: &EnvironmentVariablesPatch{
+		Add:    []gocd.EnvVars{{Name: "TOKEN", Value: "secret", Secure: true}},
+		Remove: []string{"TOKEN"},
+	}}
+	if err := goCDClient.PatchEnvironmentChanges(context.Background(), "sample", patch); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var actual map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &actual); err != nil {
+		t.Fatalf("decoding request body '%s' errored with: %v", body, err)
+	}
+
+	expected := map[string]interface{}{"environment_variables": map[string]interface{}{
+		"add":    []interface{}{map[string]interface{}{"name": "TOKEN", "value": "secret", "secure": true}},
+		"remove": []interface{}{"TOKEN"},
+	}}
+	if d := cmp.Diff(expected, actual); d != "" {
+		t.Errorf("request body mismatch (-expected +actual):\n%s", d)
+	}
+}
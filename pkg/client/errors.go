@@ -0,0 +1,35 @@
+package client
+
+import (
+	"errors"
+	"net/http"
+
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+// StatusCode returns the HTTP status code with which GoCD server rejected the call, either made by gocd-sdk-go or by this client.
+// It is 0 when the call errored without a response from GoCD server.
+func StatusCode(err error) int {
+	var statusErr StatusError
+	if errors.As(err, &statusErr) {
+		return statusErr.Code
+	}
+
+	// gocd-sdk-go returns NonOkError as a pointer.
+	var nonOkErrPtr *goErr.NonOkError
+	if errors.As(err, &nonOkErrPtr) && nonOkErrPtr != nil {
+		return nonOkErrPtr.Code
+	}
+
+	var nonOkErr goErr.NonOkError
+	if errors.As(err, &nonOkErr) {
+		return nonOkErr.Code
+	}
+
+	return 0
+}
+
+// IsNotFound reports whether GoCD server responded with 404 Not Found.
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	goErr "github.com/nikhilsbhat/gocd-sdk-go/pkg/errors"
+)
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{name: "status error of the calls made by this client", err: StatusError{Code: http.StatusConflict}, expected: http.StatusConflict},
+		{name: "pointer to NonOkError returned by gocd-sdk-go", err: &goErr.NonOkError{Code: http.StatusPreconditionFailed}, expected: http.StatusPreconditionFailed},
+		{name: "NonOkError", err: goErr.NonOkError{Code: http.StatusNotFound}, expected: http.StatusNotFound},
+		{name: "wrapped error", err: fmt.Errorf("fetching environment: %w", &goErr.NonOkError{Code: http.StatusNotFound}), expected: http.StatusNotFound},
+		{name: "error without a response", err: errors.New("connection refused"), expected: 0},
+		{name: "nil error", err: nil, expected: 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := StatusCode(test.err); actual != test.expected {
+				t.Errorf("StatusCode() = %d, expected %d", actual, test.expected)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	if !IsNotFound(&goErr.NonOkError{Code: http.StatusNotFound}) {
+		t.Error("expected 404 from gocd-sdk-go to be reported as not found")
+	}
+
+	if IsNotFound(StatusError{Code: http.StatusForbidden}) {
+		t.Error("expected 403 not to be reported as not found")
+	}
+}
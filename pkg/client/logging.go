@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// SubsystemClient is the tflog subsystem of the logs from configuring the client that connects to GoCD server.
+	SubsystemClient = "gocd_client"
+	// SubsystemAPI is the tflog subsystem of the request/response logs of the calls made to GoCD server, they are logged at TRACE.
+	SubsystemAPI = "gocd_api"
+
+	redactedValue = "***"
+
+	// sdkLogLevel silences the logs of gocd-sdk-go (including the request/response dumps of resty at debug), since they are not masked.
+	// The calls are logged under SubsystemAPI instead.
+	sdkLogLevel = "fatal"
+)
+
+var (
+	// sensitiveKeys are the keys (lower-cased, without '_' and '-') whose values are masked wherever they appear in the logs.
+	sensitiveKeys = []string{"password", "token", "secret", "encryptedvalue", "privatekey", "clientkey", "apikey"}
+	// secureMarkers are the keys which mark the value of the same object as secure, ex: secure environment variables and properties.
+	secureMarkers = []string{"secure", "issecure"}
+)
+
+// request holds the arguments of a call made to GoCD server, keyed by their names.
+type request map[string]interface{}
+
+// newLoggingContext sets up the tflog subsystems with the level set by `loglevel` on the provider and masking of the secrets passed,
+// passwords, tokens and secure values are masked irrespective of the secrets passed.
+func newLoggingContext(ctx context.Context, logLevel string, secrets []string) context.Context {
+	level := hclog.LevelFromString(logLevel)
+
+	for _, subsystem := range []string{SubsystemClient, SubsystemAPI} {
+		if level == hclog.NoLevel {
+			ctx = tflog.NewSubsystem(ctx, subsystem)
+		} else {
+			ctx = tflog.NewSubsystem(ctx, subsystem, tflog.WithLevel(level))
+		}
+
+		ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, subsystem, sensitiveKeys...)
+
+		for _, secret := range secrets {
+			if len(secret) == 0 {
+				continue
+			}
+
+			ctx = tflog.SubsystemMaskMessageStrings(ctx, subsystem, secret)
+			ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, subsystem, secret)
+		}
+	}
+
+	return ctx
+}
+
+func logAPIRequest(ctx context.Context, method string, req request) {
+	tflog.SubsystemTrace(ctx, SubsystemAPI, "calling GoCD API", map[string]interface{}{
+		"method":  method,
+		"request": redact(req),
+	})
+}
+
+func logAPIResponse(ctx context.Context, method string, response interface{}, err error, duration time.Duration) {
+	fields := map[string]interface{}{
+		"method":   method,
+		"duration": duration.String(),
+	}
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemTrace(ctx, SubsystemAPI, "GoCD API call errored", fields)
+
+		return
+	}
+
+	fields["response"] = redact(response)
+	tflog.SubsystemTrace(ctx, SubsystemAPI, "GoCD API responded", fields)
+}
+
+// redact returns the JSON of the object passed with the values of sensitive keys and secure values masked.
+func redact(object interface{}) string {
+	objectJSON, err := json.Marshal(object)
+	if err != nil {
+		return "<unable to encode: " + err.Error() + ">"
+	}
+
+	var decoded interface{}
+	if err = json.Unmarshal(objectJSON, &decoded); err != nil {
+		return "<unable to decode: " + err.Error() + ">"
+	}
+
+	redactedJSON, err := json.Marshal(redactValue(decoded))
+	if err != nil {
+		return "<unable to encode: " + err.Error() + ">"
+	}
+
+	return string(redactedJSON)
+}
+
+func redactValue(value interface{}) interface{} {
+	switch object := value.(type) {
+	case map[string]interface{}:
+		secure := isSecureObject(object)
+		for key, nested := range object {
+			if isSensitiveKey(key) || (secure && normaliseKey(key) == "value") {
+				if nested != nil && nested != "" {
+					object[key] = redactedValue
+				}
+
+				continue
+			}
+
+			object[key] = redactValue(nested)
+		}
+
+		return object
+	case []interface{}:
+		for index, nested := range object {
+			object[index] = redactValue(nested)
+		}
+
+		return object
+	default:
+		return value
+	}
+}
+
+// isSecureObject reports whether the object is marked secure, or is a property (key/value) whose key is sensitive.
+func isSecureObject(object map[string]interface{}) bool {
+	for key, value := range object {
+		normalisedKey := normaliseKey(key)
+		for _, marker := range secureMarkers {
+			if normalisedKey == marker && value == true {
+				return true
+			}
+		}
+
+		if normalisedKey == "key" {
+			if propertyKey, ok := value.(string); ok && isSensitiveKey(propertyKey) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func isSensitiveKey(key string) bool {
+	normalisedKey := normaliseKey(key)
+	for _, sensitiveKey := range sensitiveKeys {
+		if strings.Contains(normalisedKey, sensitiveKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func normaliseKey(key string) string {
+	return strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(key))
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRedact(t *testing.T) {
+	tests := []struct {
+		name     string
+		object   interface{}
+		expected string
+	}{
+		{
+			name:     "sensitive keys are masked irrespective of case and separators",
+			object:   map[string]interface{}{"name": "sample", "Password": "secret", "auth_token": "token", "encrypted-value": "AES:value", "PrivateKey": "key"},
+			expected: `{"PrivateKey":"***","Password":"***","auth_token":"***","encrypted-value":"***","name":"sample"}`,
+		},
+		{
+			name:     "empty and null sensitive values are left as is",
+			object:   map[string]interface{}{"password": "", "token": nil},
+			expected: `{"password":"","token":null}`,
+		},
+		{
+			name: "value of secure environment variables are masked",
+			object: map[string]interface{}{"environment_variables": []interface{}{
+				map[string]interface{}{"name": "USER", "value": "admin", "secure": false},
+				map[string]interface{}{"name": "PASS", "value": "admin", "secure": true},
+			}},
+			expected: `{"environment_variables":[{"name":"USER","secure":false,"value":"admin"},{"name":"PASS","secure":true,"value":"***"}]}`,
+		},
+		{
+			name: "value of properties with sensitive keys are masked",
+			object: []interface{}{
+				map[string]interface{}{"key": "Url", "value": "https://gocd.sample.com"},
+				map[string]interface{}{"key": "ClientSecret", "value": "secret"},
+			},
+			expected: `[{"key":"Url","value":"https://gocd.sample.com"},{"key":"ClientSecret","value":"***"}]`,
+		},
+		{
+			name: "structs are masked with their json keys",
+			object: struct {
+				UserName string `json:"user_name"`
+				Password string `json:"password"`
+			}{UserName: "admin", Password: "admin"},
+			expected: `{"password":"***","user_name":"admin"}`,
+		},
+		{
+			name:     "objects that cannot be encoded are reported",
+			object:   map[string]interface{}{"callback": func() {}},
+			expected: "<unable to encode: json: unsupported type: func()>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			actual := redact(test.object)
+			if actual == test.expected {
+				return
+			}
+
+			var actualObject, expectedObject interface{}
+			if json.Unmarshal([]byte(actual), &actualObject) != nil || json.Unmarshal([]byte(test.expected), &expectedObject) != nil {
+				t.Fatalf("expected %s, got %s", test.expected, actual)
+			}
+
+			if diff := cmp.Diff(expectedObject, actualObject); diff != "" {
+				t.Errorf("redact() mismatch (-expected +actual):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	tests := map[string]bool{
+		"password":        true,
+		"PASSWORD":        true,
+		"auth_token":      true,
+		"bearer-token":    true,
+		"SecretAccessKey": true,
+		"encrypted_value": true,
+		"private_key":     true,
+		"client_key":      true,
+		"api_key":         true,
+		"name":            false,
+		"value":           false,
+		"key":             false,
+		"url":             false,
+	}
+
+	for key, expected := range tests {
+		t.Run(key, func(t *testing.T) {
+			if actual := isSensitiveKey(key); actual != expected {
+				t.Errorf("isSensitiveKey(%q) = %t, expected %t", key, actual, expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// MaintenanceModeInfo is the state of maintenance mode on GoCD server along with the systems that are still running.
+// gocd-sdk-go decodes it from the `_embedded` object, which GoCD server does not return, so it is decoded here.
+type MaintenanceModeInfo struct {
+	Enabled    bool                      `json:"is_maintenance_mode,omitempty"`
+	Attributes MaintenanceModeAttributes `json:"attributes,omitempty"`
+}
+
+type MaintenanceModeAttributes struct {
+	HasRunningSystems bool           `json:"has_running_systems,omitempty"`
+	RunningSystems    RunningSystems `json:"running_systems,omitempty"`
+}
+
+type RunningSystems struct {
+	BuildingJobs  []MaintenanceJob `json:"building_jobs,omitempty"`
+	ScheduledJobs []MaintenanceJob `json:"scheduled_jobs,omitempty"`
+}
+
+type MaintenanceJob struct {
+	PipelineName    string `json:"pipeline_name,omitempty"`
+	PipelineCounter int    `json:"pipeline_counter,omitempty"`
+	StageName       string `json:"stage_name,omitempty"`
+	StageCounter    string `json:"stage_counter,omitempty"`
+	Name            string `json:"name,omitempty"`
+	State           string `json:"state,omitempty"`
+}
+
+// GetMaintenanceModeInfo fetches the state of maintenance mode on GoCD server, gocd-sdk-go does not decode the running systems,
+// so it is fetched with the same auth as the client.
+func (g GoCD) GetMaintenanceModeInfo(ctx context.Context) (MaintenanceModeInfo, error) {
+	var info MaintenanceModeInfo
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetMaintenanceModeInfo",
+		segments: []string{"api", "admin", "maintenance_mode", "info"},
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v1+json"},
+	})
+	if err != nil {
+		return info, fmt.Errorf("getting maintenance mode info errored with: %w", err)
+	}
+
+	if err = json.Unmarshal(content, &info); err != nil {
+		return info, fmt.Errorf("decoding maintenance mode info errored with: %w", err)
+	}
+
+	return info, nil
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// Material is a material known to GoCD along with its latest modification, which gocd-sdk-go does not decode.
+type Material struct {
+	gocd.Material
+	Modification MaterialModification `json:"modification,omitempty"`
+}
+
+type MaterialModification struct {
+	UserName     string `json:"username,omitempty"`
+	EmailAddress string `json:"email_address,omitempty"`
+	Revision     string `json:"revision,omitempty"`
+	Comment      string `json:"comment,omitempty"`
+	ModifiedTime string `json:"modified_time,omitempty"`
+}
+
+type materials struct {
+	Materials []Material `json:"materials,omitempty"`
+}
+
+// GetMaterials fetches all the materials known to GoCD along with their latest modifications, from the same endpoint
+// as gocd-sdk-go with the same auth as the client.
+func (g GoCD) GetMaterials(ctx context.Context) ([]Material, error) {
+	var response materials
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetMaterials",
+		segments: []string{"api", "internal", "materials"},
+		headers:  map[string]string{"Accept": gocd.HeaderVersionZero},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting materials errored with: %w", err)
+	}
+
+	if err = json.Unmarshal(content, &response); err != nil {
+		return nil, fmt.Errorf("decoding materials errored with: %w", err)
+	}
+
+	return response.Materials, nil
+}
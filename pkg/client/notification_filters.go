@@ -0,0 +1,73 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+var notificationFiltersHeaders = map[string]string{"Accept": "application/vnd.go.cd.v2+json"}
+
+// NotificationFilter decides the events of the pipelines on which the user is notified, they are managed only for the current user.
+type NotificationFilter struct {
+	ID           int    `json:"id,omitempty"`
+	Pipeline     string `json:"pipeline"`
+	Stage        string `json:"stage"`
+	Event        string `json:"event"`
+	MatchCommits bool   `json:"match_commits"`
+}
+
+// GetNotificationFilter fetches the notification filter of the user configured on the provider,
+// gocd-sdk-go does not support the notification filters, so they are managed with the same auth as the client.
+func (g GoCD) GetNotificationFilter(ctx context.Context, id int) (NotificationFilter, error) {
+	return g.notificationFilter(ctx, "GetNotificationFilter", rawRequest{
+		segments: []string{"api", "notification_filters", strconv.Itoa(id)},
+	})
+}
+
+func (g GoCD) CreateNotificationFilter(ctx context.Context, filter NotificationFilter) (NotificationFilter, error) {
+	return g.notificationFilter(ctx, "CreateNotificationFilter", rawRequest{
+		verb:     http.MethodPost,
+		segments: []string{"api", "notification_filters"},
+		body:     filter,
+	})
+}
+
+func (g GoCD) UpdateNotificationFilter(ctx context.Context, filter NotificationFilter) (NotificationFilter, error) {
+	return g.notificationFilter(ctx, "UpdateNotificationFilter", rawRequest{
+		verb:     http.MethodPatch,
+		segments: []string{"api", "notification_filters", strconv.Itoa(filter.ID)},
+		body:     filter,
+	})
+}
+
+func (g GoCD) DeleteNotificationFilter(ctx context.Context, id int) error {
+	_, err := g.do(ctx, rawRequest{
+		method:   "DeleteNotificationFilter",
+		verb:     http.MethodDelete,
+		segments: []string{"api", "notification_filters", strconv.Itoa(id)},
+		headers:  notificationFiltersHeaders,
+	})
+
+	return err
+}
+
+func (g GoCD) notificationFilter(ctx context.Context, method string, req rawRequest) (NotificationFilter, error) {
+	var filter NotificationFilter
+
+	req.method = method
+	req.headers = notificationFiltersHeaders
+
+	content, err := g.do(ctx, req)
+	if err != nil {
+		return filter, err
+	}
+
+	if err = json.Unmarshal(content, &filter); err != nil {
+		return filter, fmt.Errorf("decoding notification filter errored with: %w", err)
+	}
+
+	return filter, nil
+}
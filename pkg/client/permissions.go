@@ -0,0 +1,129 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/nikhilsbhat/terraform-provider-gocd/pkg/utils"
+)
+
+const (
+	PermissionEnvironment         = "environment"
+	PermissionConfigRepo          = "config_repo"
+	PermissionClusterProfile      = "cluster_profile"
+	PermissionElasticAgentProfile = "elastic_agent_profile"
+	PermissionPipelineGroup       = "pipeline_group"
+	PermissionRole                = "role"
+	PermissionSecretConfig        = "secret_config"
+	PermissionArtifactStore       = "artifact_store"
+	PermissionAuthConfig          = "auth_config"
+	PermissionPluginSettings      = "plugin_settings"
+)
+
+// PermissionEntityTypes are the types of the entities whose permissions are reported by GoCD.
+var PermissionEntityTypes = []string{
+	PermissionEnvironment, PermissionConfigRepo, PermissionClusterProfile, PermissionElasticAgentProfile, PermissionPipelineGroup,
+}
+
+// EntityPermissions are the names of the entities of a type which the user can view and administer.
+type EntityPermissions struct {
+	View       []string `json:"view,omitempty"`
+	Administer []string `json:"administer,omitempty"`
+}
+
+// permissionsCache holds the permissions of the user fetched once per provider instance, as they are checked by every resource on plan.
+type permissionsCache struct {
+	mutex       sync.Mutex
+	permissions map[string]EntityPermissions
+	systemAdmin *bool
+}
+
+// GetPermissions fetches what the user configured on the provider can view and administer, for the entity types passed.
+// gocd-sdk-go does not support the permissions API, so it is fetched with the same auth as the client.
+func (g GoCD) GetPermissions(ctx context.Context, entityTypes []string) (map[string]EntityPermissions, error) {
+	query := url.Values{}
+	if len(entityTypes) != 0 {
+		query.Set("type", strings.Join(entityTypes, ","))
+	}
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetPermissions",
+		segments: []string{"api", "auth", "permissions"},
+		query:    query,
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v1+json"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting permissions of the user errored with: %w", err)
+	}
+
+	var response struct {
+		Permissions map[string]EntityPermissions `json:"permissions"`
+	}
+
+	if err = json.Unmarshal(content, &response); err != nil {
+		return nil, fmt.Errorf("decoding permissions of the user errored with: %w", err)
+	}
+
+	return response.Permissions, nil
+}
+
+// CheckPermission reports whether the user configured on the provider can view and administer the entity of the type, it is checked
+// against the permissions of all the entity types, which are fetched once and cached.
+//
+// GoCD does not report the permissions of the entity types other than PermissionEntityTypes (ex: roles), they can be administered only
+// by the system admins. Entities missing from the permissions are either yet to be created or hidden from the user, only system admins
+// could create them, so they are checked against whether the user is a system admin.
+func (g GoCD) CheckPermission(ctx context.Context, entityType, name string) (bool, bool, error) {
+	if g.permissions == nil {
+		return true, true, nil
+	}
+
+	g.permissions.mutex.Lock()
+	defer g.permissions.mutex.Unlock()
+
+	if utils.Contains(PermissionEntityTypes, entityType) {
+		if g.permissions.permissions == nil {
+			permissions, err := g.GetPermissions(ctx, PermissionEntityTypes)
+			if err != nil {
+				return false, false, err
+			}
+
+			g.permissions.permissions = permissions
+		}
+
+		entityPermissions := g.permissions.permissions[entityType]
+		if utils.Contains(entityPermissions.View, name) {
+			return true, utils.Contains(entityPermissions.Administer, name), nil
+		}
+	}
+
+	systemAdmin, err := g.isSystemAdmin(ctx)
+
+	return systemAdmin, systemAdmin, err
+}
+
+// isSystemAdmin reports whether the user configured on the provider is a system admin, the system admins can be listed only by
+// the system admins themselves. It is fetched once and cached, the caller must hold the lock on the permissions.
+func (g GoCD) isSystemAdmin(ctx context.Context) (bool, error) {
+	if g.permissions.systemAdmin != nil {
+		return *g.permissions.systemAdmin, nil
+	}
+
+	systemAdmin := true
+	if _, err := g.WithContext(ctx).GetSystemAdmins(); err != nil {
+		if code := StatusCode(err); code != http.StatusUnauthorized && code != http.StatusForbidden {
+			return false, fmt.Errorf("checking whether the user is a system admin errored with: %w", err)
+		}
+
+		systemAdmin = false
+	}
+
+	g.permissions.systemAdmin = &systemAdmin
+
+	return systemAdmin, nil
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+type systemAdminsClient struct {
+	gocd.GoCd
+	err   error
+	calls int
+}
+
+func (c *systemAdminsClient) GetSystemAdmins() (gocd.SystemAdmins, error) {
+	c.calls++
+
+	return gocd.SystemAdmins{}, c.err
+}
+
+func TestCheckPermission(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		_, _ = writer.Write([]byte(`{"permissions": {"environment": {"view": ["dev", "prod"], "administer": ["dev"]}}}`))
+	}))
+
+	defer server.Close()
+
+	tests := []struct {
+		name          string
+		entityType    string
+		entity        string
+		adminErr      error
+		canView       bool
+		canAdminister bool
+		expectErr     bool
+	}{
+		{name: "entity the user administers", entityType: PermissionEnvironment, entity: "dev", canView: true, canAdminister: true},
+		{name: "entity the user only views", entityType: PermissionEnvironment, entity: "prod", canView: true},
+		{
+			name: "entity missing from the permissions of a system admin", entityType: PermissionEnvironment, entity: "qa",
+			canView: true, canAdminister: true,
+		},
+		{
+			name: "entity missing from the permissions of a user who is not a system admin", entityType: PermissionEnvironment, entity: "qa",
+			adminErr: StatusError{Code: http.StatusForbidden},
+		},
+		{name: "entity type not reported, checked for a system admin", entityType: PermissionRole, entity: "admins", canView: true, canAdminister: true},
+		{name: "entity type not reported, checked for a user", entityType: PermissionRole, entity: "admins", adminErr: StatusError{Code: http.StatusUnauthorized}},
+		{name: "failing to check for a system admin errors", entityType: PermissionRole, entity: "admins", adminErr: errors.New("connection refused"), expectErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			sdkClient := &systemAdminsClient{err: test.adminErr}
+			goCDClient := GoCD{GoCd: sdkClient, baseURL: server.URL + "/go", permissions: &permissionsCache{}}
+
+			for range 2 {
+				canView, canAdminister, err := goCDClient.CheckPermission(context.Background(), test.entityType, test.entity)
+				if (err != nil) != test.expectErr {
+					t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+				}
+
+				if canView != test.canView || canAdminister != test.canAdminister {
+					t.Errorf("expected view: %t, administer: %t, got view: %t, administer: %t", test.canView, test.canAdminister, canView, canAdminister)
+				}
+			}
+
+			if !test.expectErr && sdkClient.calls > 1 {
+				t.Errorf("expected system admins to be fetched at most once, fetched %d times", sdkClient.calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// pipelineConfigsCache holds the configs of all the pipelines fetched once per provider instance, as the data sources computing
+// the dependencies between the pipelines are usually declared once per pipeline.
+type pipelineConfigsCache struct {
+	mutex   sync.Mutex
+	configs []gocd.PipelineConfig
+}
+
+// GetPipelineConfigs fetches the configs of all the pipelines, in the order of their groups. GoCD has no API listing the configs
+// of all the pipelines, the pipeline groups list only their names, so the groups are listed once and every config is fetched once
+// per provider instance, until InvalidatePipelineConfigs is called. The configs are shared, they must not be modified.
+func (g GoCD) GetPipelineConfigs(ctx context.Context) ([]gocd.PipelineConfig, error) {
+	if g.pipelineConfigs == nil {
+		return g.getPipelineConfigs(ctx)
+	}
+
+	g.pipelineConfigs.mutex.Lock()
+	defer g.pipelineConfigs.mutex.Unlock()
+
+	if g.pipelineConfigs.configs == nil {
+		configs, err := g.getPipelineConfigs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		g.pipelineConfigs.configs = configs
+	}
+
+	return g.pipelineConfigs.configs, nil
+}
+
+// InvalidatePipelineConfigs drops the configs cached by GetPipelineConfigs, it is called when a pipeline is created, updated or deleted,
+// so that the data sources read later in the same run see the change.
+func (g GoCD) InvalidatePipelineConfigs() {
+	if g.pipelineConfigs == nil {
+		return
+	}
+
+	g.pipelineConfigs.mutex.Lock()
+	defer g.pipelineConfigs.mutex.Unlock()
+
+	g.pipelineConfigs.configs = nil
+}
+
+func (g GoCD) getPipelineConfigs(ctx context.Context) ([]gocd.PipelineConfig, error) {
+	goCDClient := g.WithContext(ctx)
+
+	groups, err := goCDClient.GetPipelineGroups()
+	if err != nil {
+		return nil, fmt.Errorf("getting pipeline groups errored with: %w", err)
+	}
+
+	configs := make([]gocd.PipelineConfig, 0)
+
+	for _, group := range groups {
+		for _, pipeline := range group.Pipelines {
+			pipelineCfg, err := goCDClient.GetPipelineConfig(pipeline.Name)
+			if err != nil {
+				return nil, fmt.Errorf("getting pipeline configuration %s errored with: %w", pipeline.Name, err)
+			}
+
+			// gocd-sdk-go does not set the name and the group of the pipeline on the config it fetches.
+			pipelineCfg.Name, pipelineCfg.Group = pipeline.Name, group.Name
+			configs = append(configs, pipelineCfg)
+		}
+	}
+
+	return configs, nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+type pipelineConfigsClient struct {
+	gocd.GoCd
+	groups []gocd.PipelineGroup
+	calls  int
+}
+
+func (c *pipelineConfigsClient) GetPipelineGroups() ([]gocd.PipelineGroup, error) {
+	return c.groups, nil
+}
+
+func (c *pipelineConfigsClient) GetPipelineConfig(name string) (gocd.PipelineConfig, error) {
+	c.calls++
+
+	return gocd.PipelineConfig{Config: map[string]interface{}{"label_template": name}}, nil
+}
+
+func TestGetPipelineConfigs(t *testing.T) {
+	sdkClient := &pipelineConfigsClient{groups: []gocd.PipelineGroup{
+		{Name: "build", Pipelines: []gocd.Pipeline{{Name: "compile"}, {Name: "test"}}},
+		{Name: "release", Pipelines: []gocd.Pipeline{{Name: "deploy"}}},
+	}}
+	goCDClient := GoCD{GoCd: sdkClient, pipelineConfigs: &pipelineConfigsCache{}}
+
+	for range 2 {
+		configs, err := goCDClient.GetPipelineConfigs(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(configs) != 3 || configs[0].Name != "compile" || configs[0].Group != "build" || configs[2].Name != "deploy" || configs[2].Group != "release" {
+			t.Fatalf("unexpected pipeline configs: %v", configs)
+		}
+	}
+
+	if sdkClient.calls != 3 {
+		t.Errorf("expected every pipeline config to be fetched once, fetched %d times", sdkClient.calls)
+	}
+
+	goCDClient.InvalidatePipelineConfigs()
+
+	if _, err := goCDClient.GetPipelineConfigs(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if sdkClient.calls != 6 {
+		t.Errorf("expected pipeline configs to be fetched again once invalidated, fetched %d times", sdkClient.calls)
+	}
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// pipelineConfigAccept is the version of the pipeline config API, the same one used by gocd-sdk-go.
+const pipelineConfigAccept = "application/vnd.go.cd.v11+json"
+
+// PipelineConfigFields is the config of a pipeline as returned by GoCD server, along with its etag.
+type PipelineConfigFields struct {
+	Fields map[string]interface{}
+	ETAG   string
+}
+
+// GetPipelineConfigFields fetches the config of the pipeline as returned by GoCD server. gocd-sdk-go decodes the config into its own
+// types, dropping the fields it does not know of, so it is fetched with the same auth as the client to be sent back unchanged.
+func (g GoCD) GetPipelineConfigFields(ctx context.Context, name string) (PipelineConfigFields, error) {
+	config := PipelineConfigFields{}
+	header := http.Header{}
+
+	content, err := g.get(ctx, rawRequest{
+		method:         "GetPipelineConfigFields",
+		segments:       []string{"api", "admin", "pipelines", name},
+		headers:        map[string]string{"Accept": pipelineConfigAccept},
+		responseHeader: header,
+	})
+	if err != nil {
+		return config, fmt.Errorf("getting config of pipeline '%s' errored with: %w", name, err)
+	}
+
+	if err = json.Unmarshal(content, &config.Fields); err != nil {
+		return config, fmt.Errorf("decoding config of pipeline '%s' errored with: %w", name, err)
+	}
+
+	config.ETAG = header.Get("ETag")
+
+	return config, nil
+}
+
+// MovePipelineToGroup moves the pipeline to the group by sending back its config fetched with GetPipelineConfigFields with only
+// the group changed, GoCD rejects it when the pipeline was modified since the config was fetched (412 Precondition Failed).
+// GoCD has no API to only move a pipeline: the pipeline config API has no PATCH and takes the group only as part of the whole config,
+// while the pipeline group API updates only the name and the authorization of a group and not its pipelines.
+func (g GoCD) MovePipelineToGroup(ctx context.Context, name, group string, config PipelineConfigFields) error {
+	fields := make(map[string]interface{}, len(config.Fields))
+	for key, value := range config.Fields {
+		// the hypermedia links are not part of the config.
+		if !strings.HasPrefix(key, "_") {
+			fields[key] = value
+		}
+	}
+
+	fields["group"] = group
+
+	_, err := g.do(ctx, rawRequest{
+		method:   "MovePipelineToGroup",
+		verb:     http.MethodPut,
+		segments: []string{"api", "admin", "pipelines", name},
+		headers:  map[string]string{"Accept": pipelineConfigAccept, "If-Match": config.ETAG},
+		body:     fields,
+	})
+	if err != nil {
+		return fmt.Errorf("moving pipeline '%s' to group '%s' errored with: %w", name, group, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMovePipelineToGroup(t *testing.T) {
+	var ifMatch string
+
+	var updated map[string]interface{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/go/api/admin/pipelines/build" || req.Header.Get("Accept") != pipelineConfigAccept {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		switch req.Method {
+		case http.MethodGet:
+			writer.Header().Set("ETag", `"etag-one"`)
+			_, _ = writer.Write([]byte(`{"_links": {"self": {"href": "https://gocd/go/api/admin/pipelines/build"}}, "name": "build", "group": "default",
+"label_template": "${COUNT}", "environment_variables": [{"name": "TOKEN", "secure": true, "encrypted_value": "AES:encrypted"}],
+"stages": [{"name": "compile", "fetch_materials": true}]}`))
+		case http.MethodPut:
+			ifMatch = req.Header.Get("If-Match")
+			content, _ := io.ReadAll(req.Body)
+			_ = json.Unmarshal(content, &updated)
+		}
+	}))
+
+	defer server.Close()
+
+	goCDClient := GoCD{baseURL: server.URL + "/go"}
+
+	config, err := goCDClient.GetPipelineConfigFields(context.Background(), "build")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if config.ETAG != `"etag-one"` || config.Fields["group"] != "default" {
+		t.Fatalf("unexpected pipeline config: %v", config)
+	}
+
+	if err = goCDClient.MovePipelineToGroup(context.Background(), "build", "release", config); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ifMatch != `"etag-one"` {
+		t.Errorf("expected the pipeline to be updated with the etag of its config, got: %s", ifMatch)
+	}
+
+	expected := map[string]interface{}{
+		"name": "build", "group": "release", "label_template": "${COUNT}",
+		"environment_variables": []interface{}{map[string]interface{}{"name": "TOKEN", "secure": true, "encrypted_value": "AES:encrypted"}},
+		"stages":                []interface{}{map[string]interface{}{"name": "compile", "fetch_materials": true}},
+	}
+	if d := cmp.Diff(expected, updated); d != "" {
+		t.Errorf("updated pipeline config mismatch (-expected +actual):\n%s", d)
+	}
+
+	if config.Fields["group"] != "default" {
+		t.Errorf("expected the fetched config to be left unchanged, got group: %v", config.Fields["group"])
+	}
+}
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// minPipelineHistoryPageSize is the smallest page size accepted by GoCD server for the history of a pipeline.
+const minPipelineHistoryPageSize = 10
+
+// PipelineInstance is a run of a pipeline along with its stages and the material revisions it ran with. gocd-sdk-go decodes
+// neither the stages nor the material revisions of the pipeline history, so they are decoded here.
+type PipelineInstance struct {
+	Name          string                  `json:"name,omitempty"`
+	Counter       int                     `json:"counter,omitempty"`
+	Label         string                  `json:"label,omitempty"`
+	ScheduledDate int64                   `json:"scheduled_date,omitempty"`
+	BuildCause    PipelineBuildCause      `json:"build_cause,omitempty"`
+	Stages        []PipelineStageInstance `json:"stages,omitempty"`
+}
+
+type PipelineBuildCause struct {
+	TriggerMessage    string                     `json:"trigger_message,omitempty"`
+	TriggerForced     bool                       `json:"trigger_forced,omitempty"`
+	Approver          string                     `json:"approver,omitempty"`
+	MaterialRevisions []PipelineMaterialRevision `json:"material_revisions,omitempty"`
+}
+
+type PipelineMaterialRevision struct {
+	Changed       bool                   `json:"changed,omitempty"`
+	Material      PipelineMaterial       `json:"material,omitempty"`
+	Modifications []PipelineModification `json:"modifications,omitempty"`
+}
+
+type PipelineMaterial struct {
+	Name        string `json:"name,omitempty"`
+	Fingerprint string `json:"fingerprint,omitempty"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+type PipelineModification struct {
+	Revision     string `json:"revision,omitempty"`
+	ModifiedTime int64  `json:"modified_time,omitempty"`
+	UserName     string `json:"user_name,omitempty"`
+	Comment      string `json:"comment,omitempty"`
+}
+
+type PipelineStageInstance struct {
+	Name          string                `json:"name,omitempty"`
+	Counter       string                `json:"counter,omitempty"`
+	Scheduled     bool                  `json:"scheduled,omitempty"`
+	Status        string                `json:"status,omitempty"`
+	Result        string                `json:"result,omitempty"`
+	ApprovedBy    string                `json:"approved_by,omitempty"`
+	ScheduledDate int64                 `json:"scheduled_date,omitempty"`
+	Jobs          []PipelineJobInstance `json:"jobs,omitempty"`
+}
+
+type PipelineJobInstance struct {
+	Name          string `json:"name,omitempty"`
+	State         string `json:"state,omitempty"`
+	Result        string `json:"result,omitempty"`
+	ScheduledDate int64  `json:"scheduled_date,omitempty"`
+}
+
+type pipelineHistory struct {
+	Pipelines []PipelineInstance `json:"pipelines,omitempty"`
+}
+
+// GetPipelineHistory fetches the most recent instances of the pipeline, latest first. gocd-sdk-go decodes only the counters
+// and the build causes of the history, so it is fetched with the same auth as the client.
+func (g GoCD) GetPipelineHistory(ctx context.Context, pipeline string, pageSize int) ([]PipelineInstance, error) {
+	var history pipelineHistory
+
+	if pageSize < minPipelineHistoryPageSize {
+		pageSize = minPipelineHistoryPageSize
+	}
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetPipelineHistory",
+		segments: []string{"api", "pipelines", pipeline, "history"},
+		query:    url.Values{"page_size": []string{strconv.Itoa(pageSize)}},
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v1+json"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting history of pipeline '%s' errored with: %w", pipeline, err)
+	}
+
+	if err = json.Unmarshal(content, &history); err != nil {
+		return nil, fmt.Errorf("decoding history of pipeline '%s' errored with: %w", pipeline, err)
+	}
+
+	return history.Pipelines, nil
+}
+
+// GetPipelineInstance fetches the instance of the pipeline with the counter, gocd-sdk-go returns it undecoded,
+// so it is fetched with the same auth as the client.
+func (g GoCD) GetPipelineInstance(ctx context.Context, pipeline string, counter int) (PipelineInstance, error) {
+	var instance PipelineInstance
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetPipelineInstance",
+		segments: []string{"api", "pipelines", pipeline, strconv.Itoa(counter)},
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v1+json"},
+	})
+	if err != nil {
+		return instance, fmt.Errorf("getting instance '%d' of pipeline '%s' errored with: %w", counter, pipeline, err)
+	}
+
+	if err = json.Unmarshal(content, &instance); err != nil {
+		return instance, fmt.Errorf("decoding instance '%d' of pipeline '%s' errored with: %w", counter, pipeline, err)
+	}
+
+	return instance, nil
+}
@@ -0,0 +1,209 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const maxResponseSize = 10 * 1024 * 1024
+
+// rawRequest is a request to GoCD server for the calls gocd-sdk-go does not support.
+type rawRequest struct {
+	// method is the name by which the call is logged.
+	method string
+	// verb is the HTTP method of the request, defaults to GET.
+	verb string
+	// segments are the segments of the path relative to the base url of GoCD server, they are escaped.
+	segments []string
+	query    url.Values
+	headers  map[string]string
+	// body is encoded to JSON when set.
+	body interface{}
+	// files are sent as a multipart form by their names and contents under the form field formField, when set instead of the body.
+	formField string
+	files     map[string]string
+	// maxSize is the max size of the response in bytes, the call errors when the response is larger. Defaults to 10 MiB.
+	maxSize int64
+	// responseHeader is filled with the headers of the response when set, ex: to read the etag of the resource.
+	responseHeader http.Header
+}
+
+// StatusError is returned by the calls gocd-sdk-go does not support, when GoCD server responds with a non 2xx status.
+type StatusError struct {
+	Code   int
+	Status string
+	Body   string
+}
+
+func (e StatusError) Error() string {
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("GoCD server responded with status: %s", e.Status)
+	}
+
+	return fmt.Sprintf("GoCD server responded with status: %s, body: %s", e.Status, e.Body)
+}
+
+// get fetches the resource from GoCD server with the same auth as the client.
+func (g GoCD) get(ctx context.Context, req rawRequest) ([]byte, error) {
+	req.verb = http.MethodGet
+
+	return g.do(ctx, req)
+}
+
+// do makes the request to GoCD server with the same auth as the client.
+func (g GoCD) do(ctx context.Context, req rawRequest) ([]byte, error) {
+	ctx = newLoggingContext(ctx, g.logLevel, g.secrets)
+
+	if len(req.verb) == 0 {
+		req.verb = http.MethodGet
+	}
+
+	if req.maxSize == 0 {
+		req.maxSize = maxResponseSize
+	}
+
+	escapedSegments := make([]string, 0, len(req.segments))
+	for _, segment := range req.segments {
+		escapedSegments = append(escapedSegments, url.PathEscape(segment))
+	}
+
+	resourceURL := strings.TrimSuffix(g.baseURL, "/") + "/" + strings.Join(escapedSegments, "/")
+	if len(req.query) != 0 {
+		resourceURL += "?" + req.query.Encode()
+	}
+
+	fileNames := make([]string, 0, len(req.files))
+	for name := range req.files {
+		fileNames = append(fileNames, name)
+	}
+
+	sort.Strings(fileNames)
+
+	logAPIRequest(ctx, req.method, request{
+		"verb": req.verb, "path": strings.Join(escapedSegments, "/"), "query": req.query, "body": req.body, "files": fileNames, "max_size": req.maxSize,
+	})
+
+	start := time.Now()
+
+	content, err := g.doRequest(ctx, req, resourceURL)
+
+	logAPIResponse(ctx, req.method, map[string]interface{}{"size": len(content)}, err, time.Since(start))
+
+	return content, err
+}
+
+func (g GoCD) doRequest(ctx context.Context, req rawRequest, resourceURL string) ([]byte, error) {
+	var body io.Reader
+
+	var contentType string
+
+	switch {
+	case len(req.files) != 0:
+		form, formContentType, err := getMultipartForm(req.formField, req.files)
+		if err != nil {
+			return nil, err
+		}
+
+		body, contentType = form, formContentType
+	case req.body != nil:
+		bodyJSON, err := json.Marshal(req.body)
+		if err != nil {
+			return nil, err
+		}
+
+		body, contentType = bytes.NewReader(bodyJSON), "application/json"
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.verb, resourceURL, body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(contentType) != 0 {
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
+	for key, value := range req.headers {
+		httpReq.Header.Set(key, value)
+	}
+
+	switch {
+	case len(g.auth.BearerToken) != 0:
+		httpReq.Header.Set("Authorization", "Bearer "+g.auth.BearerToken)
+	case len(g.auth.UserName) != 0:
+		httpReq.SetBasicAuth(g.auth.UserName, g.auth.Password)
+	}
+
+	transport := g.transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	resp, err := (&http.Client{Transport: transport}).Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	// one byte more than the cap is read to find out whether the response is larger than the cap, without reading all of it.
+	content, err := io.ReadAll(io.LimitReader(resp.Body, req.maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, StatusError{Code: resp.StatusCode, Status: resp.Status, Body: strings.TrimSpace(string(content))}
+	}
+
+	if int64(len(content)) > req.maxSize {
+		return nil, fmt.Errorf("response is larger than the max size of %d bytes", req.maxSize)
+	}
+
+	if req.responseHeader != nil {
+		for key, values := range resp.Header {
+			req.responseHeader[key] = values
+		}
+	}
+
+	return content, nil
+}
+
+// getMultipartForm returns the multipart form with the files under the field, along with its content type.
+func getMultipartForm(field string, files map[string]string) (io.Reader, string, error) {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var form bytes.Buffer
+
+	writer := multipart.NewWriter(&form)
+	for _, name := range names {
+		part, err := writer.CreateFormFile(field, name)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if _, err = io.WriteString(part, files[name]); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return &form, writer.FormDataContentType(), nil
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// RoleUsersOperation are the users to be added to and removed from a role of type gocd.
+type RoleUsersOperation struct {
+	Role  string    `json:"role,omitempty"`
+	Users AddRemove `json:"users,omitempty"`
+}
+
+type roleOperations struct {
+	Operations []RoleUsersOperation `json:"operations,omitempty"`
+}
+
+// PatchRoleUsers adds and removes the users of the role of type gocd in a single call, GoCD applies them on its latest config
+// so the rest of the role is left untouched without having to fetch and update it with its etag.
+// gocd-sdk-go does not support the bulk update of the roles, so it is patched with the same auth as the client.
+func (g GoCD) PatchRoleUsers(ctx context.Context, name string, users AddRemove) error {
+	_, err := g.do(ctx, rawRequest{
+		method:   "PatchRoleUsers",
+		verb:     http.MethodPatch,
+		segments: []string{"api", "admin", "security", "roles"},
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v3+json"},
+		body:     roleOperations{Operations: []RoleUsersOperation{{Role: name, Users: users}}},
+	})
+	if err != nil {
+		return fmt.Errorf("patching users of role '%s' errored with: %w", name, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestPatchRoleUsers(t *testing.T) {
+	var verb, path, accept, body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, req *http.Request) {
+		content, _ := io.ReadAll(req.Body)
+		verb, path, accept, body = req.Method, req.URL.EscapedPath(), req.Header.Get("Accept"), string(content)
+	}))
+
+	defer server.Close()
+
+	goCDClient := GoCD{baseURL: server.URL + "/go"}
+
+	if err := goCDClient.PatchRoleUsers(context.Background(), "developers", AddRemove{Add: []string{"jane"}, Remove: []string{"john"}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if verb != http.MethodPatch || path != "/go/api/admin/security/roles" || accept != "application/vnd.go.cd.v3+json" {
+		t.Errorf("unexpected request '%s %s' with Accept '%s'", verb, path, accept)
+	}
+
+	var operations roleOperations
+	if err := json.Unmarshal([]byte(body), &operations); err != nil {
+		t.Fatalf("decoding request body '%s' errored with: %v", body, err)
+	}
+
+	expected := roleOperations{Operations: []RoleUsersOperation{{Role: "developers", Users: AddRemove{Add: []string{"jane"}, Remove: []string{"john"}}}}}
+	if d := cmp.Diff(expected, operations); d != "" {
+		t.Errorf("request body mismatch (-expected +actual):\n%s", d)
+	}
+}
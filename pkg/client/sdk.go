@@ -0,0 +1,147 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+const sdkForwarderReadHeaderTimeout = 30 * time.Second
+
+// sdkForwarder forwards the requests of gocd-sdk-go clients to GoCD server. gocd-sdk-go accepts neither a transport nor a context,
+// so its clients are pointed at the forwarder listening on the loopback interface, which makes their requests to GoCD server
+// over the transport of the provider, with the context the client is bound to.
+type sdkForwarder struct {
+	url       string
+	secret    string
+	proxy     *httputil.ReverseProxy
+	newClient func(baseURL string) gocd.GoCd
+
+	mutex    sync.Mutex
+	created  int
+	clients  map[context.Context]*sdkClient
+	contexts map[string]context.Context
+	// idle are the clients released by the contexts that are done, they are bound again to the next contexts.
+	idle []*sdkClient
+}
+
+// sdkClient is a gocd-sdk-go client whose requests are forwarded with the context bound to its token.
+type sdkClient struct {
+	gocd.GoCd
+	token string
+}
+
+// newSDKForwarder starts the forwarder for the requests made to GoCD server at baseURL, the clients are created with newClient
+// and the base url passed to it.
+func newSDKForwarder(baseURL string, transport http.RoundTripper, newClient func(baseURL string) gocd.GoCd) (*sdkForwarder, error) {
+	target, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("parsing base url '%s' errored with: %w", baseURL, err)
+	}
+
+	// the tokens are prefixed with a secret, so that the forwarder cannot be used by other processes on the host.
+	secret := make([]byte, 16) //nolint:mnd
+	if _, err = rand.Read(secret); err != nil {
+		return nil, fmt.Errorf("generating secret for the forwarder errored with: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("listening on the loopback interface errored with: %w", err)
+	}
+
+	forwarder := &sdkForwarder{
+		url:       "http://" + listener.Addr().String(),
+		secret:    hex.EncodeToString(secret),
+		newClient: newClient,
+		clients:   make(map[context.Context]*sdkClient),
+		contexts:  make(map[string]context.Context),
+	}
+
+	forwarder.proxy = &httputil.ReverseProxy{
+		Rewrite: func(req *httputil.ProxyRequest) {
+			req.SetURL(target)
+		},
+		Transport: transport,
+		ErrorHandler: func(writer http.ResponseWriter, _ *http.Request, err error) {
+			http.Error(writer, fmt.Sprintf("forwarding the request to GoCD server errored with: %v", err), http.StatusBadGateway)
+		},
+	}
+
+	server := &http.Server{Handler: forwarder, ReadHeaderTimeout: sdkForwarderReadHeaderTimeout}
+
+	go server.Serve(listener) //nolint:errcheck
+
+	return forwarder, nil
+}
+
+func (f *sdkForwarder) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	token, _, _ := strings.Cut(strings.TrimPrefix(req.URL.Path, "/"), "/")
+
+	f.mutex.Lock()
+	ctx, ok := f.contexts[token]
+	f.mutex.Unlock()
+
+	if !ok {
+		http.Error(writer, "requests are forwarded only for the clients of the provider", http.StatusForbidden)
+
+		return
+	}
+
+	req = req.WithContext(ctx)
+	req.URL.Path = strings.TrimPrefix(req.URL.Path, "/"+token)
+	req.URL.RawPath = strings.TrimPrefix(req.URL.RawPath, "/"+token)
+
+	f.proxy.ServeHTTP(writer, req)
+}
+
+// client returns the gocd-sdk-go client bound to the context, the client is released once the context is done
+// and is reused for the next contexts, so that clients are created only for the contexts that are in use at once.
+func (f *sdkForwarder) client(ctx context.Context) gocd.GoCd {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if client, ok := f.clients[ctx]; ok {
+		return client.GoCd
+	}
+
+	var client *sdkClient
+	if count := len(f.idle); count != 0 {
+		client = f.idle[count-1]
+		f.idle = f.idle[:count-1]
+	} else {
+		f.created++
+		token := fmt.Sprintf("%s-%d", f.secret, f.created)
+		client = &sdkClient{GoCd: f.newClient(f.url + "/" + token), token: token}
+	}
+
+	f.clients[ctx] = client
+	f.contexts[client.token] = ctx
+
+	context.AfterFunc(ctx, func() {
+		f.release(ctx)
+	})
+
+	return client.GoCd
+}
+
+func (f *sdkForwarder) release(ctx context.Context) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	if client, ok := f.clients[ctx]; ok {
+		delete(f.clients, ctx)
+		delete(f.contexts, client.token)
+		f.idle = append(f.idle, client)
+	}
+}
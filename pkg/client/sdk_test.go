@@ -0,0 +1,130 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+func newTestSDKForwarder(t *testing.T, handler http.HandlerFunc) *sdkForwarder {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	forwarder, err := newSDKForwarder(server.URL+"/go", http.DefaultTransport, func(baseURL string) gocd.GoCd {
+		goCDClient := gocd.NewClient(baseURL, gocd.Auth{UserName: "admin", Password: "secret"}, sdkLogLevel, nil)
+		goCDClient.SetRetryCount(0)
+
+		return goCDClient
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return forwarder
+}
+
+func TestSDKForwarder(t *testing.T) {
+	t.Run("requests of the client are forwarded to GoCD server with its auth", func(t *testing.T) {
+		forwarder := newTestSDKForwarder(t, func(writer http.ResponseWriter, req *http.Request) {
+			if username, password, _ := req.BasicAuth(); req.URL.Path != "/go/api/version" || username != "admin" || password != "secret" {
+				http.Error(writer, "unexpected request", http.StatusBadRequest)
+
+				return
+			}
+
+			_, _ = writer.Write([]byte(`{"version": "23.1.0"}`))
+		})
+
+		versionInfo, err := forwarder.client(context.Background()).GetVersionInfo()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if versionInfo.Version != "23.1.0" {
+			t.Errorf("expected version 23.1.0, got: %s", versionInfo.Version)
+		}
+	})
+
+	t.Run("requests are cancelled with the context the client is bound to", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		forwarder := newTestSDKForwarder(t, func(_ http.ResponseWriter, req *http.Request) {
+			select {
+			case <-release:
+			case <-req.Context().Done():
+			}
+		})
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+
+		if _, err := forwarder.client(ctx).GetVersionInfo(); err == nil {
+			t.Fatal("expected the request to be cancelled with the context")
+		}
+
+		if elapsed := time.Since(start); elapsed > 5*time.Second {
+			t.Errorf("request was not cancelled when the context was done, it took %s", elapsed)
+		}
+	})
+
+	t.Run("client is reused for the context and released once it is done", func(t *testing.T) {
+		forwarder := newTestSDKForwarder(t, func(http.ResponseWriter, *http.Request) {})
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		if forwarder.client(ctx) != forwarder.client(ctx) {
+			t.Errorf("expected the same client for the context")
+		}
+
+		cancel()
+
+		deadline := time.Now().Add(5 * time.Second)
+		for forwarder.bound() != 0 && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+
+		if bound := forwarder.bound(); bound != 0 {
+			t.Errorf("expected the client to be released once the context is done, %d are bound", bound)
+		}
+
+		forwarder.client(context.Background())
+
+		if forwarder.created != 1 {
+			t.Errorf("expected the released client to be reused for the next context, %d clients were created", forwarder.created)
+		}
+	})
+
+	t.Run("requests with unknown tokens are rejected", func(t *testing.T) {
+		forwarder := newTestSDKForwarder(t, func(http.ResponseWriter, *http.Request) {
+			t.Error("request with unknown token was forwarded")
+		})
+
+		resp, err := http.Get(forwarder.url + "/unknown/api/version")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusForbidden {
+			t.Errorf("expected status 403, got: %d", resp.StatusCode)
+		}
+	})
+}
+
+// bound returns the number of contexts the clients are bound to.
+func (f *sdkForwarder) bound() int {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	return len(f.clients)
+}
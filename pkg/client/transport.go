@@ -0,0 +1,125 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// transportConfig holds the options of the HTTP transport used to connect to GoCD server.
+type transportConfig struct {
+	ca                 []byte
+	insecureSkipVerify bool
+	clientCert         []byte
+	clientKey          []byte
+	proxyURL           string
+	requestTimeout     time.Duration
+	headers            map[string]string
+}
+
+// customised reports whether any of the options has to be applied on the transport, apart from the CA that gocd-sdk-go supports natively.
+func (cfg transportConfig) customised() bool {
+	return cfg.insecureSkipVerify || len(cfg.clientCert) != 0 || len(cfg.proxyURL) != 0 ||
+		cfg.requestTimeout != 0 || len(cfg.headers) != 0
+}
+
+func (cfg transportConfig) transport() (http.RoundTripper, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.insecureSkipVerify, //nolint:gosec
+	}
+
+	if len(cfg.ca) != 0 {
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(cfg.ca) {
+			return nil, fmt.Errorf("no valid certificates were found in the CA passed")
+		}
+
+		tlsConfig.RootCAs = certPool
+	}
+
+	if len(cfg.clientCert) != 0 || len(cfg.clientKey) != 0 {
+		certificate, err := tls.X509KeyPair(cfg.clientCert, cfg.clientKey)
+		if err != nil {
+			return nil, fmt.Errorf("loading client certificate and key errored with: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	if len(cfg.proxyURL) != 0 {
+		proxyURL, err := url.Parse(cfg.proxyURL)
+		if err != nil {
+			return nil, fmt.Errorf("parsing proxy url '%s' errored with: %w", cfg.proxyURL, err)
+		}
+
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	return &customTransport{
+		transport: transport,
+		timeout:   cfg.requestTimeout,
+		headers:   cfg.headers,
+	}, nil
+}
+
+// customTransport sets the custom headers and the request timeout on every request made to GoCD server.
+type customTransport struct {
+	transport http.RoundTripper
+	timeout   time.Duration
+	headers   map[string]string
+}
+
+func (t *customTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+
+	if t.timeout == 0 {
+		return t.transport.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, err
+	}
+
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+
+	return resp, nil
+}
+
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+
+	return c.ReadCloser.Close()
+}
+
+// readFileOrContent returns the content of the file when a path is passed, else the value is considered as content itself.
+func readFileOrContent(value string) ([]byte, error) {
+	if len(value) == 0 || strings.HasPrefix(strings.TrimSpace(value), "-----BEGIN") {
+		return []byte(value), nil
+	}
+
+	return os.ReadFile(value)
+}
@@ -0,0 +1,53 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// ValueStreamMap is the value stream map of a pipeline instance, nodes are grouped in levels from the materials to the downstream pipelines.
+type ValueStreamMap struct {
+	CurrentPipeline string                `json:"current_pipeline,omitempty"`
+	Levels          []ValueStreamMapLevel `json:"levels,omitempty"`
+}
+
+type ValueStreamMapLevel struct {
+	Nodes []ValueStreamMapNode `json:"nodes,omitempty"`
+}
+
+// ValueStreamMapNode is either a pipeline or a material, the nodes of materials are identified by their fingerprints.
+type ValueStreamMapNode struct {
+	ID         string                   `json:"id,omitempty"`
+	Name       string                   `json:"name,omitempty"`
+	NodeType   string                   `json:"node_type,omitempty"`
+	Parents    []string                 `json:"parents,omitempty"`
+	Dependents []string                 `json:"dependents,omitempty"`
+	Instances  []ValueStreamMapInstance `json:"instances,omitempty"`
+}
+
+type ValueStreamMapInstance struct {
+	Counter int    `json:"counter,omitempty"`
+	Label   string `json:"label,omitempty"`
+}
+
+// GetValueStreamMap fetches the value stream map of the pipeline instance, gocd-sdk-go does not support it,
+// so it is fetched from the endpoint backing the value stream map page of GoCD. The endpoint is not part of the documented API
+// and GoCD does not version it with the Accept header, so a change in its response surfaces as the decoding error.
+func (g GoCD) GetValueStreamMap(ctx context.Context, pipeline string, counter int) (ValueStreamMap, error) {
+	var valueStreamMap ValueStreamMap
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetValueStreamMap",
+		segments: []string{"pipelines", "value_stream_map", pipeline, fmt.Sprintf("%d.json", counter)},
+	})
+	if err != nil {
+		return valueStreamMap, fmt.Errorf("getting value stream map of instance '%d' of pipeline '%s' errored with: %w", counter, pipeline, err)
+	}
+
+	if err = json.Unmarshal(content, &valueStreamMap); err != nil {
+		return valueStreamMap, fmt.Errorf("decoding value stream map of instance '%d' of pipeline '%s' errored with: %w", counter, pipeline, err)
+	}
+
+	return valueStreamMap, nil
+}
@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-version"
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+// Feature is a capability of GoCD server used by the provider, which is available only from MinVersion of GoCD.
+type Feature struct {
+	Name       string
+	MinVersion string
+}
+
+var (
+	FeatureArtifactStores  = Feature{Name: "artifact stores", MinVersion: "18.7.0"}
+	FeatureMaintenanceMode = Feature{Name: "maintenance mode", MinVersion: "19.1.0"}
+	FeatureClusterProfiles = Feature{Name: "cluster profiles", MinVersion: "19.3.0"}
+	FeatureSecretConfigs   = Feature{Name: "secret configs", MinVersion: "19.6.0"}
+	FeatureBackupConfig    = Feature{Name: "backup config", MinVersion: "19.6.0"}
+	FeatureRolePolicies    = Feature{Name: "policies on roles", MinVersion: "19.11.0"}
+	FeatureConfigRepoRules = Feature{Name: "rules on config repositories", MinVersion: "20.2.0"}
+	FeaturePermissions     = Feature{Name: "permissions of the current user", MinVersion: "19.11.0"}
+)
+
+// UnsupportedFeatureError is returned when GoCD server is older than the version from which the feature is available.
+type UnsupportedFeatureError struct {
+	Feature       Feature
+	ServerVersion string
+}
+
+func (e UnsupportedFeatureError) Error() string {
+	return fmt.Sprintf("%s are supported from GoCD %s, but GoCD server is running %s", e.Feature.Name, e.Feature.MinVersion, e.ServerVersion)
+}
+
+// versionCache holds the version of GoCD server fetched once per provider instance, either while configuring the provider
+// or on the first call that needs it when `skip_check` is set.
+type versionCache struct {
+	mutex       sync.Mutex
+	fetched     bool
+	versionInfo *gocd.VersionInfo
+}
+
+// GetServerVersion returns the version of GoCD server, it is fetched on the first call when it was not while configuring the provider.
+// It is nil when it could not be fetched.
+func (g GoCD) GetServerVersion(ctx context.Context) *gocd.VersionInfo {
+	if g.VersionInfo != nil || g.version == nil {
+		return g.VersionInfo
+	}
+
+	g.version.mutex.Lock()
+	defer g.version.mutex.Unlock()
+
+	if !g.version.fetched {
+		g.version.versionInfo = getVersionInfo(ctx, g.WithContext(ctx))
+		g.version.fetched = true
+	}
+
+	return g.version.versionInfo
+}
+
+// CheckFeature returns UnsupportedFeatureError when GoCD server is older than the version from which the feature is available.
+// The check is skipped when the version of GoCD server could not be fetched.
+func (g GoCD) CheckFeature(ctx context.Context, feature Feature) error {
+	versionInfo := g.GetServerVersion(ctx)
+	if versionInfo == nil {
+		return nil
+	}
+
+	serverVersion, err := version.NewVersion(versionInfo.Version)
+	if err != nil {
+		// versions that cannot be parsed (ex: custom builds) are not checked.
+		return nil //nolint:nilerr
+	}
+
+	if serverVersion.LessThan(version.Must(version.NewVersion(feature.MinVersion))) {
+		return UnsupportedFeatureError{Feature: feature, ServerVersion: versionInfo.Version}
+	}
+
+	return nil
+}
+
+// ServerVersion is the version of GoCD server along with the build it is running. gocd-sdk-go does not decode the build number
+// and the commit url of the version, so it is decoded here.
+type ServerVersion struct {
+	gocd.VersionInfo
+	BuildNumber string `json:"build_number,omitempty"`
+	CommitURL   string `json:"commit_url,omitempty"`
+}
+
+// GetServerVersionDetails fetches the version of GoCD server along with the build it is running,
+// with the same auth as the client.
+func (g GoCD) GetServerVersionDetails(ctx context.Context) (ServerVersion, error) {
+	var serverVersion ServerVersion
+
+	content, err := g.get(ctx, rawRequest{
+		method:   "GetServerVersionDetails",
+		segments: []string{"api", "version"},
+		headers:  map[string]string{"Accept": "application/vnd.go.cd.v1+json"},
+	})
+	if err != nil {
+		return serverVersion, fmt.Errorf("getting version of GoCD server errored with: %w", err)
+	}
+
+	if err = json.Unmarshal(content, &serverVersion); err != nil {
+		return serverVersion, fmt.Errorf("decoding version of GoCD server errored with: %w", err)
+	}
+
+	return serverVersion, nil
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/nikhilsbhat/gocd-sdk-go"
+)
+
+type versionClient struct {
+	gocd.GoCd
+	version string
+	err     error
+	calls   int
+}
+
+func (c *versionClient) GetVersionInfo() (gocd.VersionInfo, error) {
+	c.calls++
+
+	return gocd.VersionInfo{Version: c.version}, c.err
+}
+
+func TestCheckFeature(t *testing.T) {
+	feature := Feature{Name: "secret configs", MinVersion: "19.6.0"}
+
+	tests := []struct {
+		name        string
+		versionInfo *gocd.VersionInfo
+		sdkClient   *versionClient
+		expectErr   bool
+	}{
+		{name: "server newer than the feature", versionInfo: &gocd.VersionInfo{Version: "23.1.0"}},
+		{name: "server of the same version as the feature", versionInfo: &gocd.VersionInfo{Version: "19.6.0"}},
+		{name: "server older than the feature", versionInfo: &gocd.VersionInfo{Version: "19.3.0"}, expectErr: true},
+		{name: "version that cannot be parsed is not checked", versionInfo: &gocd.VersionInfo{Version: "custom"}},
+		{name: "version is fetched when it was not while configuring", sdkClient: &versionClient{version: "18.1.0"}, expectErr: true},
+		{name: "check is skipped when the version cannot be fetched", sdkClient: &versionClient{err: errors.New("connection refused")}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			goCDClient := GoCD{VersionInfo: test.versionInfo, version: &versionCache{}}
+			if test.sdkClient != nil {
+				goCDClient.GoCd = test.sdkClient
+			}
+
+			err := goCDClient.CheckFeature(context.Background(), feature)
+			if (err != nil) != test.expectErr {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+
+			var unsupportedErr UnsupportedFeatureError
+			if test.expectErr && (!errors.As(err, &unsupportedErr) || unsupportedErr.Feature != feature) {
+				t.Errorf("expected UnsupportedFeatureError for the feature, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetServerVersion(t *testing.T) {
+	sdkClient := &versionClient{version: "23.1.0"}
+	goCDClient := GoCD{GoCd: sdkClient, version: &versionCache{}}
+
+	for range 3 {
+		if versionInfo := goCDClient.GetServerVersion(context.Background()); versionInfo == nil || versionInfo.Version != "23.1.0" {
+			t.Fatalf("unexpected version: %v", versionInfo)
+		}
+	}
+
+	if sdkClient.calls != 1 {
+		t.Errorf("expected the version to be fetched once, fetched %d times", sdkClient.calls)
+	}
+}
@@ -78,6 +78,8 @@ const (
 	TerraformResourcePipelineGroupID     = "group_id"
 	TerraformResourceAuthorization       = "authorization"
 	TerraformResourcePolicy              = "policy"
+	TerraformResourcePermission          = "permission"
+	TerraformResourceDirective           = "directive"
 	TerraformResourceUsers               = "users"
 	TerraformResourceRoles               = "roles"
 	TerraformResourceAuthConfigID        = "auth_config_id"
@@ -86,7 +88,109 @@ const (
 	TerraformResourceRetries             = "retries"
 	TerraformResourceCount               = "count"
 	TerraformResourceWaitTime            = "wait_time"
+	TerraformResourceCAFilePath          = "ca_file_path"
+	TerraformResourceInsecureSkipVerify  = "insecure_skip_verify"
+	TerraformResourceClientCert          = "client_cert"
+	TerraformResourceClientKey           = "client_key"
+	TerraformResourceProxyURL            = "proxy_url"
+	TerraformResourceRequestTimeout      = "request_timeout"
+	TerraformResourceConfigPath          = "config_path"
+	TerraformResourceProfile             = "profile"
+	TerraformResourceOnConflict          = "on_conflict"
+	TerraformResourceHeaders             = "headers"
 	TerraformResourceExtensions          = "extensions"
 	TerraformResourceSystemAdmin         = "system_admin"
 	TerraformResourceIsAdmin             = "is_admin"
+	TerraformResourceFiles               = "files"
+	TerraformResourceRepoID              = "repo_id"
+	TerraformResourceValid               = "valid"
+	TerraformResourceErrors              = "errors"
+	TerraformResourceEnvironment         = "environment"
+	TerraformResourceAgentID             = "agent_id"
+	TerraformResourceSecure              = "secure"
+	TerraformResourceValueChecksum       = "value_checksum"
+	TerraformResourceSecureVariables     = "secure_variables"
+	TerraformResourceRole                = "role"
+	TerraformResourceUser                = "user"
+	TerraformResourceProperty            = "property"
+	TerraformResourceSeparator           = "separator"
+	TerraformResourcePipelineGroup       = "pipeline_group"
+	TerraformResourceVersion             = "version"
+	TerraformResourceBuildNumber         = "build_number"
+	TerraformResourceGitSHA              = "git_sha"
+	TerraformResourceFullVersion         = "full_version"
+	TerraformResourceCommitURL           = "commit_url"
+	TerraformResourceHealth              = "health"
+	TerraformResourceMaintenanceMode     = "maintenance_mode"
+	TerraformResourceEnabled             = "enabled"
+	TerraformResourceWaitForJobs         = "wait_for_jobs"
+	TerraformResourcePollInterval        = "poll_interval"
+	TerraformResourceBuildingJobs        = "building_jobs"
+	TerraformResourceScheduledJobs       = "scheduled_jobs"
+	TerraformResourceHasRunningSystems   = "has_running_systems"
+	TerraformResourceMinInterval         = "min_interval"
+	TerraformResourceMaxInterval         = "max_interval"
+	TerraformResourceMessages            = "messages"
+	TerraformResourceLevel               = "level"
+	TerraformResourceMessage             = "message"
+	TerraformResourceDetail              = "detail"
+	TerraformResourceTime                = "time"
+	TerraformResourceTriggers            = "triggers"
+	TerraformResourceNameRegex           = "name_regex"
+	TerraformResourceFilter              = "filter"
+	TerraformResourceValues              = "values"
+	TerraformResourceNames               = "names"
+	TerraformResourceID                  = "id"
+	TerraformResourceClusterProfiles     = "cluster_profiles"
+	TerraformResourceSecretConfigs       = "secret_configs"
+	TerraformResourceArtifactStores      = "artifact_stores"
+	TerraformResourceAuthConfigs         = "auth_configs"
+	TerraformResourceElasticProfiles     = "elastic_agent_profiles"
+	TerraformResourceConfigRepositories  = "config_repositories"
+	TerraformResourcePipelineGroups      = "pipeline_groups"
+	TerraformResourcePlugins             = "plugins"
+	TerraformResourceExtension           = "extension"
+	TerraformResourceRequiredPlugins     = "required_plugins"
+	TerraformResourceCounter             = "counter"
+	TerraformResourceLimit               = "limit"
+	TerraformResourceInstances           = "instances"
+	TerraformResourceLatestCounter       = "latest_counter"
+	TerraformResourceLatestResult        = "latest_result"
+	TerraformResourceResult              = "result"
+	TerraformResourcePaused              = "paused"
+	TerraformResourcePausedBy            = "paused_by"
+	TerraformResourceLocked              = "locked"
+	TerraformResourceSchedulable         = "schedulable"
+	TerraformResourcePipelineCounter     = "pipeline_counter"
+	TerraformResourceStageCounter        = "stage_counter"
+	TerraformResourceJob                 = "job"
+	TerraformResourcePath                = "path"
+	TerraformResourceMaxSize             = "max_size"
+	TerraformResourceContent             = "content"
+	TerraformResourceContentBase64       = "content_base64"
+	TerraformResourceSize                = "size"
+	TerraformResourceSHA256              = "sha256"
+	TerraformResourceNodes               = "nodes"
+	TerraformResourceEdges               = "edges"
+	TerraformResourceUpstream            = "upstream"
+	TerraformResourceDownstream          = "downstream"
+	TerraformResourceAllUpstream         = "all_upstream"
+	TerraformResourceAllDownstream       = "all_downstream"
+	TerraformResourceCycles              = "cycles"
+	TerraformResourceOrphanedPipelines   = "orphaned_pipelines"
+	TerraformResourceMaterials           = "materials"
+	TerraformResourceFingerprint         = "fingerprint"
+	TerraformResourceCheckPermissions    = "check_permissions"
+	TerraformResourceTypes               = "types"
+	TerraformResourceAdminister          = "administer"
+	TerraformResourceEvent               = "event"
+	TerraformResourceMatchCommits        = "match_commits"
+	TerraformResourceJobs                = "jobs"
+	TerraformResourceBuildPipeline       = "build_pipeline"
+	TerraformResourceBuildPipelineCount  = "build_pipeline_counter"
+	TerraformResourceBuildStage          = "build_stage"
+	TerraformResourceBuildStageCounter   = "build_stage_counter"
+	TerraformResourceBuildJob            = "build_job"
+	TerraformResourceBuildJobURL         = "build_job_url"
+	TerraformResourceStages              = "stages"
 )
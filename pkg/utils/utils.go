@@ -7,8 +7,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 )
 
+const membershipIDSeparator = "/"
+
 // GetRandomID returns a random id when invoked.
 func GetRandomID() (string, error) {
 	randInt := 10
@@ -95,3 +98,18 @@ func Contains(slice []string, str string) bool {
 
 	return false
 }
+
+// GetMembershipID returns the ID of the resources that manage a single member of a GoCD entity, ex: a pipeline of an environment.
+func GetMembershipID(parent, member string) string {
+	return parent + membershipIDSeparator + member
+}
+
+// ParseMembershipID parses the ID generated by GetMembershipID back to the parent and the member.
+func ParseMembershipID(id string) (string, string, error) {
+	parent, member, found := strings.Cut(id, membershipIDSeparator)
+	if !found || len(parent) == 0 || len(member) == 0 {
+		return "", "", fmt.Errorf("invalid ID '%s', it should be of format '<parent>%s<member>'", id, membershipIDSeparator)
+	}
+
+	return parent, member, nil
+}